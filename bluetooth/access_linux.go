@@ -0,0 +1,122 @@
+package bluetooth
+
+import (
+	"github.com/godbus/dbus/v5"
+)
+
+// AccessPolicy decides which remote devices may use the peripheral.
+//
+// BlueZ reports the identity address of bonded devices that distribute an
+// IRK, so listing that address covers all resolvable private addresses the
+// device rotates through. Devices whose IRK is known but that are not bonded
+// with this adapter can be listed in AllowIRKs instead.
+type AccessPolicy struct {
+	// Allow, when not empty, is the only set of devices that may connect
+	// together with the devices resolved by AllowIRKs.
+	Allow []MAC
+	// AllowIRKs admits devices whose address resolves with one of the keys.
+	AllowIRKs []IRK
+	// Deny lists devices that are always refused.
+	Deny []MAC
+	// PairedOnly refuses every device that is not paired with the adapter.
+	PairedOnly bool
+}
+
+// AccessDeniedEvent describes a device that was refused by the policy.
+type AccessDeniedEvent struct {
+	Device Device
+	// Reason is "connect", "read" or "write".
+	Reason string
+}
+
+// Allowed reports whether the policy lets device use the peripheral.
+func (p *AccessPolicy) Allowed(device Device) bool {
+	for _, mac := range p.Deny {
+		if mac == device.Address.MAC {
+			return false
+		}
+	}
+	if len(p.Allow) != 0 || len(p.AllowIRKs) != 0 {
+		allowed := false
+		for _, mac := range p.Allow {
+			if mac == device.Address.MAC {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			_, allowed = ResolveRPA(device.Address.MAC, p.AllowIRKs)
+		}
+		if !allowed {
+			return false
+		}
+	}
+	if p.PairedOnly && !device.Paired {
+		return false
+	}
+	return true
+}
+
+// SetAccessPolicy restricts which devices may connect and use the GATT
+// services of this adapter. Devices that are refused are disconnected right
+// away and their reads and writes are rejected. A nil policy allows all
+// devices.
+func (a *Adapter) SetAccessPolicy(policy *AccessPolicy) {
+	a.mu.Lock()
+	a.accessPolicy = policy
+	a.mu.Unlock()
+}
+
+// SetAccessDeniedHandler sets a callback that is invoked whenever a device
+// is refused by the access policy.
+func (a *Adapter) SetAccessDeniedHandler(handler func(event AccessDeniedEvent)) {
+	a.accessDeniedHandler = handler
+}
+
+// allowed reports whether device passes the current access policy.
+func (a *Adapter) allowed(device Device) bool {
+	a.mu.Lock()
+	policy := a.accessPolicy
+	a.mu.Unlock()
+
+	return policy == nil || policy.Allowed(device)
+}
+
+// checkAccess returns false and reports the event if device is refused.
+func (a *Adapter) checkAccess(device Device, reason string) bool {
+	if a.allowed(device) {
+		return true
+	}
+	a.accessDeniedHandler(AccessDeniedEvent{Device: device, Reason: reason})
+	return false
+}
+
+// checkAccessPath looks up the device behind a GATT request and checks it
+// against the access policy.
+func (a *Adapter) checkAccessPath(options map[string]dbus.Variant, reason string) bool {
+	a.mu.Lock()
+	policy := a.accessPolicy
+	a.mu.Unlock()
+	if policy == nil {
+		return true
+	}
+
+	path, ok := options["device"].Value().(dbus.ObjectPath)
+	if !ok {
+		return false
+	}
+	device := Device{
+		device:  a.bus.Object("org.bluez", path),
+		adapter: a,
+	}
+	var props map[string]dbus.Variant
+	if err := device.device.Call("org.freedesktop.DBus.Properties.GetAll", 0, bluezDevice1Interface).Store(&props); err != nil {
+		return false
+	}
+	if err := device.parseProperties(&props); err != nil {
+		return false
+	}
+	return a.checkAccess(device, reason)
+}
+
+var errNotAuthorized = dbus.NewError("org.bluez.Error.NotAuthorized", nil)
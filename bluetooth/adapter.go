@@ -1,8 +1,10 @@
 package bluetooth
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/godbus/dbus/v5"
 )
@@ -19,22 +21,54 @@ type Adapter struct {
 	defaultAdvertisement *Advertisement
 
 	connectHandler func(device Device, connected bool)
+	stateHandler   func(info AdapterInfo)
+	adapterSigCh   chan *dbus.Signal
+
+	// Registrations that are restored when bluetoothd restarts.
+	mu              sync.Mutex
+	applications    []dbus.ObjectPath
+	advertisements  []*Advertisement
+	monitors        []dbus.ObjectPath
+	bluezSigCh      chan *dbus.Signal
+	recoveryHandler func(event RecoveryEvent)
+
+	accessPolicy        *AccessPolicy
+	accessDeniedHandler func(event AccessDeniedEvent)
+
+	// Connection IDs handed out to GATT clients, by device object path.
+	connections    map[dbus.ObjectPath]Connection
+	lastConnection Connection
 }
 
 func NewAdapter(id string) *Adapter {
 	return &Adapter{
 		id:             id,
 		connectHandler: func(device Device, connected bool) {},
+		stateHandler:   func(info AdapterInfo) {},
+
+		recoveryHandler: func(event RecoveryEvent) {},
+
+		accessDeniedHandler: func(event AccessDeniedEvent) {},
 	}
 }
 
 var DefaultAdapter = NewAdapter(defaultAdapter)
 
+// ID returns the BlueZ name of the adapter, e.g. "hci0".
+func (a *Adapter) ID() string {
+	return a.id
+}
+
 func (a *Adapter) SetConnectionHandler(handler func(device Device, connected bool)) {
 	a.connectHandler = handler
 }
 
 func (a *Adapter) Enable() (err error) {
+	return a.EnableContext(context.Background())
+}
+
+// EnableContext is like Enable but gives up when ctx is done.
+func (a *Adapter) EnableContext(ctx context.Context) (err error) {
 	bus, err := dbus.SystemBus()
 	if err != nil {
 		return err
@@ -44,17 +78,20 @@ func (a *Adapter) Enable() (err error) {
 	a.bluez = a.bus.Object("org.bluez", dbus.ObjectPath("/"))
 	a.adapter = a.bus.Object("org.bluez", dbus.ObjectPath("/org/bluez/"+a.id))
 	fmt.Println("Adapter path:", a.adapter.Path())
-	addr, err := a.adapter.GetProperty("org.bluez.Adapter1.Address")
+	addr, err := getProperty(ctx, a.adapter, "org.bluez.Adapter1", "Address")
 	// fmt.Println(addr)
 	if err != nil {
 		if err, ok := err.(dbus.Error); ok && err.Name == "org.freedesktop.DBus.Error.UnknownObject" {
-			return fmt.Errorf("bluetooth: adapter %s does not exist", a.adapter.Path())
+			return fmt.Errorf("%w: %s", ErrAdapterNotFound, a.adapter.Path())
 		}
 		return fmt.Errorf("could not activate BlueZ adapter: %w", err)
 	}
 	addr.Store(&a.address)
 	fmt.Printf("DBus Variant: %v\n", addr.Value())
-	return nil
+	if err := a.startAdapterWatch(); err != nil {
+		return err
+	}
+	return a.startBlueZWatch()
 }
 
 func (a *Adapter) Address() (MACAddress, error) {
@@ -0,0 +1,319 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const bluezAdapter1Interface = "org.bluez.Adapter1"
+
+// AdapterInfo is a snapshot of the org.bluez.Adapter1 properties.
+type AdapterInfo struct {
+	// ID is the adapter name used by BlueZ, e.g. "hci0".
+	ID                  string
+	Address             MACAddress
+	Name                string
+	Alias               string
+	Class               uint32
+	Powered             bool
+	Discoverable        bool
+	DiscoverableTimeout time.Duration
+	Pairable            bool
+	PairableTimeout     time.Duration
+	Discovering         bool
+	// Roles lists the supported roles, e.g. "central" and "peripheral".
+	Roles []string
+	// AdvertisingFeatures and AdvertisingInstances come from
+	// LEAdvertisingManager1 and are only filled in by Adapters.
+	AdvertisingFeatures  []string
+	AdvertisingInstances byte
+}
+
+// SetStateChangeHandler sets a callback that is invoked with a fresh
+// AdapterInfo whenever BlueZ reports a change of the adapter properties, for
+// example when rfkill powers the radio off.
+func (a *Adapter) SetStateChangeHandler(handler func(info AdapterInfo)) {
+	a.stateHandler = handler
+}
+
+// Powered reports whether the adapter radio is switched on.
+func (a *Adapter) Powered() (bool, error) {
+	return a.PoweredContext(context.Background())
+}
+
+// PoweredContext is like Powered but gives up when ctx is done.
+func (a *Adapter) PoweredContext(ctx context.Context) (bool, error) {
+	v, err := getProperty(ctx, a.adapter, bluezAdapter1Interface, "Powered")
+	if err != nil {
+		return false, err
+	}
+	powered, _ := v.Value().(bool)
+	return powered, nil
+}
+
+// SetPowered switches the adapter radio on or off.
+func (a *Adapter) SetPowered(powered bool) error {
+	return a.SetPoweredContext(context.Background(), powered)
+}
+
+// SetPoweredContext is like SetPowered but gives up when ctx is done.
+func (a *Adapter) SetPoweredContext(ctx context.Context, powered bool) error {
+	if err := setProperty(ctx, a.adapter, bluezAdapter1Interface, "Powered", powered); err != nil {
+		return fmt.Errorf("bluetooth: could not set adapter power: %w", err)
+	}
+	return nil
+}
+
+// SetDiscoverable makes the adapter visible to classic inquiries. A zero
+// timeout keeps it discoverable until it is switched off again.
+func (a *Adapter) SetDiscoverable(discoverable bool, timeout time.Duration) error {
+	return a.SetDiscoverableContext(context.Background(), discoverable, timeout)
+}
+
+// SetDiscoverableContext is like SetDiscoverable but gives up when ctx is
+// done.
+func (a *Adapter) SetDiscoverableContext(ctx context.Context, discoverable bool, timeout time.Duration) error {
+	if discoverable {
+		if err := setProperty(ctx, a.adapter, bluezAdapter1Interface, "DiscoverableTimeout", uint32(timeout/time.Second)); err != nil {
+			return fmt.Errorf("bluetooth: could not set discoverable timeout: %w", err)
+		}
+	}
+	if err := setProperty(ctx, a.adapter, bluezAdapter1Interface, "Discoverable", discoverable); err != nil {
+		return fmt.Errorf("bluetooth: could not set discoverable: %w", err)
+	}
+	return nil
+}
+
+// SetPairable allows or refuses new pairings. A zero timeout keeps the
+// adapter pairable until it is switched off again.
+func (a *Adapter) SetPairable(pairable bool, timeout time.Duration) error {
+	return a.SetPairableContext(context.Background(), pairable, timeout)
+}
+
+// SetPairableContext is like SetPairable but gives up when ctx is done.
+func (a *Adapter) SetPairableContext(ctx context.Context, pairable bool, timeout time.Duration) error {
+	if pairable {
+		if err := setProperty(ctx, a.adapter, bluezAdapter1Interface, "PairableTimeout", uint32(timeout/time.Second)); err != nil {
+			return fmt.Errorf("bluetooth: could not set pairable timeout: %w", err)
+		}
+	}
+	if err := setProperty(ctx, a.adapter, bluezAdapter1Interface, "Pairable", pairable); err != nil {
+		return fmt.Errorf("bluetooth: could not set pairable: %w", err)
+	}
+	return nil
+}
+
+// SetAlias sets the friendly name that remote devices see.
+func (a *Adapter) SetAlias(alias string) error {
+	return a.SetAliasContext(context.Background(), alias)
+}
+
+// SetAliasContext is like SetAlias but gives up when ctx is done.
+func (a *Adapter) SetAliasContext(ctx context.Context, alias string) error {
+	if err := setProperty(ctx, a.adapter, bluezAdapter1Interface, "Alias", alias); err != nil {
+		return fmt.Errorf("bluetooth: could not set adapter alias: %w", err)
+	}
+	return nil
+}
+
+// Name returns the system name of the adapter.
+func (a *Adapter) Name() (string, error) {
+	return a.NameContext(context.Background())
+}
+
+// NameContext is like Name but gives up when ctx is done.
+func (a *Adapter) NameContext(ctx context.Context) (string, error) {
+	v, err := getProperty(ctx, a.adapter, bluezAdapter1Interface, "Name")
+	if err != nil {
+		return "", err
+	}
+	name, _ := v.Value().(string)
+	return name, nil
+}
+
+// Class returns the Bluetooth class of device of the adapter.
+func (a *Adapter) Class() (uint32, error) {
+	return a.ClassContext(context.Background())
+}
+
+// ClassContext is like Class but gives up when ctx is done.
+func (a *Adapter) ClassContext(ctx context.Context) (uint32, error) {
+	v, err := getProperty(ctx, a.adapter, bluezAdapter1Interface, "Class")
+	if err != nil {
+		return 0, err
+	}
+	class, _ := v.Value().(uint32)
+	return class, nil
+}
+
+// Info returns all adapter properties in one call.
+func (a *Adapter) Info() (AdapterInfo, error) {
+	return a.InfoContext(context.Background())
+}
+
+// InfoContext is like Info but gives up when ctx is done.
+func (a *Adapter) InfoContext(ctx context.Context) (AdapterInfo, error) {
+	var props map[string]dbus.Variant
+	err := a.adapter.CallWithContext(ctx, "org.freedesktop.DBus.Properties.GetAll", 0, bluezAdapter1Interface).Store(&props)
+	if err != nil {
+		return AdapterInfo{}, wrapError(err)
+	}
+	info := parseAdapterInfo(props)
+	info.ID = a.id
+	return info, nil
+}
+
+func parseAdapterInfo(props map[string]dbus.Variant) AdapterInfo {
+	var info AdapterInfo
+	if addr, ok := props["Address"].Value().(string); ok {
+		if mac, err := ParseMAC(addr); err == nil {
+			info.Address = MACAddress{MAC: mac}
+		}
+	}
+	info.Name, _ = props["Name"].Value().(string)
+	info.Alias, _ = props["Alias"].Value().(string)
+	info.Class, _ = props["Class"].Value().(uint32)
+	info.Powered, _ = props["Powered"].Value().(bool)
+	info.Discoverable, _ = props["Discoverable"].Value().(bool)
+	info.Pairable, _ = props["Pairable"].Value().(bool)
+	info.Discovering, _ = props["Discovering"].Value().(bool)
+	info.Roles, _ = props["Roles"].Value().([]string)
+	if timeout, ok := props["DiscoverableTimeout"].Value().(uint32); ok {
+		info.DiscoverableTimeout = time.Duration(timeout) * time.Second
+	}
+	if timeout, ok := props["PairableTimeout"].Value().(uint32); ok {
+		info.PairableTimeout = time.Duration(timeout) * time.Second
+	}
+	return info
+}
+
+// WaitPowered blocks until the adapter reports Powered=true or ctx is done.
+func (a *Adapter) WaitPowered(ctx context.Context) error {
+	sigCh := make(chan *dbus.Signal, 4)
+	a.bus.Signal(sigCh)
+	defer a.bus.RemoveSignal(sigCh)
+
+	matchOptions := a.matchOptionsAdapterChanged()
+	if err := a.bus.AddMatchSignalContext(ctx, matchOptions...); err != nil {
+		return fmt.Errorf("bluetooth: add dbus match signal: PropertiesChanged: %w", err)
+	}
+	defer a.bus.RemoveMatchSignal(matchOptions...)
+
+	v, err := getProperty(ctx, a.adapter, bluezAdapter1Interface, "Powered")
+	if err != nil {
+		return err
+	}
+	if powered, _ := v.Value().(bool); powered {
+		return nil
+	}
+
+	for {
+		select {
+		case sig, ok := <-sigCh:
+			if !ok {
+				return fmt.Errorf("bluetooth: wait for power: %w", dbus.ErrClosed)
+			}
+			if changes, ok := a.adapterChanges(sig); ok {
+				if powered, _ := changes["Powered"].Value().(bool); powered {
+					return nil
+				}
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrAdapterNotPowered, ctx.Err())
+		}
+	}
+}
+
+// EnablePowered enables the adapter, switches the radio on and waits until
+// BlueZ reports it as powered.
+func (a *Adapter) EnablePowered(ctx context.Context) error {
+	if err := a.EnableContext(ctx); err != nil {
+		return err
+	}
+	if err := a.SetPoweredContext(ctx, true); err != nil {
+		return err
+	}
+	return a.WaitPowered(ctx)
+}
+
+func (a *Adapter) matchOptionsAdapterChanged() []dbus.MatchOption {
+	return []dbus.MatchOption{dbus.WithMatchInterface("org.freedesktop.DBus.Properties"),
+		dbus.WithMatchMember("PropertiesChanged"),
+		dbus.WithMatchObjectPath(a.adapter.Path()),
+		dbus.WithMatchArg(dbusPropertiesChangedInterfaceName, bluezAdapter1Interface)}
+}
+
+// adapterChanges returns the changed properties if sig is a
+// PropertiesChanged signal for this adapter.
+func (a *Adapter) adapterChanges(sig *dbus.Signal) (map[string]dbus.Variant, bool) {
+	if sig == nil || sig.Name != dbusSignalPropertiesChanged || sig.Path != a.adapter.Path() {
+		return nil, false
+	}
+	if interfaceName, ok := sig.Body[dbusPropertiesChangedInterfaceName].(string); !ok || interfaceName != bluezAdapter1Interface {
+		return nil, false
+	}
+	changes, ok := sig.Body[dbusPropertiesChangedDictionary].(map[string]dbus.Variant)
+	return changes, ok
+}
+
+// watchAdapter calls the state change handler on every Adapter1
+// PropertiesChanged signal until the signal channel is closed by Close.
+func (a *Adapter) watchAdapter(sigCh chan *dbus.Signal) {
+	for sig := range sigCh {
+		if _, ok := a.adapterChanges(sig); !ok {
+			continue
+		}
+		info, err := a.Info()
+		if err != nil {
+			continue
+		}
+		a.stateHandler(info)
+	}
+}
+
+func (a *Adapter) startAdapterWatch() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.adapterSigCh != nil {
+		return nil
+	}
+	if err := a.bus.AddMatchSignal(a.matchOptionsAdapterChanged()...); err != nil {
+		return fmt.Errorf("bluetooth: add dbus match signal: PropertiesChanged: %w", err)
+	}
+	a.adapterSigCh = make(chan *dbus.Signal, 8)
+	a.bus.Signal(a.adapterSigCh)
+	go a.watchAdapter(a.adapterSigCh)
+	return nil
+}
+
+// Close stops watching the adapter for state changes and bluetoothd
+// restarts and removes the match rules that Enable added. Registered
+// services and advertisements are left alone. The adapter may be enabled
+// again afterwards.
+func (a *Adapter) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var err error
+	if a.adapterSigCh != nil {
+		// RemoveSignal waits for pending deliveries, so the channel can
+		// be closed safely afterwards.
+		a.bus.RemoveSignal(a.adapterSigCh)
+		close(a.adapterSigCh)
+		a.adapterSigCh = nil
+		if rerr := a.bus.RemoveMatchSignal(a.matchOptionsAdapterChanged()...); rerr != nil {
+			err = fmt.Errorf("bluetooth: remove dbus match signal: PropertiesChanged: %w", rerr)
+		}
+	}
+	if a.bluezSigCh != nil {
+		a.bus.RemoveSignal(a.bluezSigCh)
+		close(a.bluezSigCh)
+		a.bluezSigCh = nil
+		if rerr := a.bus.RemoveMatchSignal(matchOptionsNameOwnerChanged...); rerr != nil && err == nil {
+			err = fmt.Errorf("bluetooth: remove dbus match signal: NameOwnerChanged: %w", rerr)
+		}
+	}
+	return err
+}
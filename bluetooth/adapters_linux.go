@@ -0,0 +1,177 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	bluezLEAdvertisingManager1Interface = "org.bluez.LEAdvertisingManager1"
+
+	dbusSignalInterfacesRemoved = "org.freedesktop.DBus.ObjectManager.InterfacesRemoved"
+
+	dbusInterfacesRemovedList = 1
+)
+
+var ErrNoPoweredAdapter = errors.New("bluetooth: no powered adapter found")
+
+// AdapterEvent reports an adapter appearing on or disappearing from the
+// system, e.g. when a USB dongle is plugged in or pulled out.
+type AdapterEvent struct {
+	// Added is true when the adapter appeared and false when it went away.
+	Added bool
+	// Info describes the adapter. Only ID is set for removed adapters.
+	Info AdapterInfo
+}
+
+// Adapters lists every adapter BlueZ knows about, ordered by ID.
+func Adapters(ctx context.Context) ([]AdapterInfo, error) {
+	bus, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+
+	var objects map[dbus.ObjectPath]map[string]map[string]dbus.Variant
+	err = bus.Object("org.bluez", dbus.ObjectPath("/")).CallWithContext(ctx, "org.freedesktop.DBus.ObjectManager.GetManagedObjects", 0).Store(&objects)
+	if err != nil {
+		return nil, fmt.Errorf("bluetooth: could not list adapters: %w", wrapError(err))
+	}
+
+	var adapters []AdapterInfo
+	for objPath, interfaces := range objects {
+		if _, ok := interfaces[bluezAdapter1Interface]; !ok {
+			continue
+		}
+		adapters = append(adapters, parseAdapterObject(objPath, interfaces))
+	}
+	sort.Slice(adapters, func(i, j int) bool {
+		return adapters[i].ID < adapters[j].ID
+	})
+	return adapters, nil
+}
+
+// FirstPoweredAdapter returns an adapter for the first powered BlueZ
+// adapter, or ErrNoPoweredAdapter if all radios are off.
+func FirstPoweredAdapter() (*Adapter, error) {
+	adapters, err := Adapters(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range adapters {
+		if info.Powered {
+			return NewAdapter(info.ID), nil
+		}
+	}
+	return nil, ErrNoPoweredAdapter
+}
+
+// WatchAdapters reports adapters being added or removed until ctx is done,
+// after which the returned channel is closed.
+func WatchAdapters(ctx context.Context) (<-chan AdapterEvent, error) {
+	bus, err := dbus.SystemBus()
+	if err != nil {
+		return nil, err
+	}
+
+	matchOptionsAdded := []dbus.MatchOption{dbus.WithMatchSender("org.bluez"),
+		dbus.WithMatchInterface("org.freedesktop.DBus.ObjectManager"),
+		dbus.WithMatchMember("InterfacesAdded")}
+	matchOptionsRemoved := []dbus.MatchOption{dbus.WithMatchSender("org.bluez"),
+		dbus.WithMatchInterface("org.freedesktop.DBus.ObjectManager"),
+		dbus.WithMatchMember("InterfacesRemoved")}
+
+	if err := bus.AddMatchSignalContext(ctx, matchOptionsAdded...); err != nil {
+		return nil, fmt.Errorf("bluetooth: add dbus match signal: InterfacesAdded: %w", err)
+	}
+	if err := bus.AddMatchSignalContext(ctx, matchOptionsRemoved...); err != nil {
+		bus.RemoveMatchSignal(matchOptionsAdded...)
+		return nil, fmt.Errorf("bluetooth: add dbus match signal: InterfacesRemoved: %w", err)
+	}
+
+	sigCh := make(chan *dbus.Signal, 8)
+	bus.Signal(sigCh)
+
+	events := make(chan AdapterEvent)
+	go func() {
+		defer close(events)
+		defer bus.RemoveMatchSignal(matchOptionsRemoved...)
+		defer bus.RemoveMatchSignal(matchOptionsAdded...)
+		defer bus.RemoveSignal(sigCh)
+
+		for {
+			var event AdapterEvent
+			select {
+			case <-ctx.Done():
+				return
+			case sig, ok := <-sigCh:
+				if !ok || sig == nil {
+					return // connection closed
+				}
+				if event, ok = parseAdapterSignal(sig); !ok {
+					continue
+				}
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+func parseAdapterSignal(sig *dbus.Signal) (AdapterEvent, bool) {
+	if len(sig.Body) <= dbusInterfacesAddedDictionary {
+		return AdapterEvent{}, false
+	}
+	objPath, ok := sig.Body[0].(dbus.ObjectPath)
+	if !ok {
+		return AdapterEvent{}, false
+	}
+
+	switch sig.Name {
+	case dbusSignalInterfacesAdded:
+		interfaces, ok := sig.Body[dbusInterfacesAddedDictionary].(map[string]map[string]dbus.Variant)
+		if !ok {
+			return AdapterEvent{}, false
+		}
+		if _, ok := interfaces[bluezAdapter1Interface]; !ok {
+			return AdapterEvent{}, false
+		}
+		return AdapterEvent{Added: true, Info: parseAdapterObject(objPath, interfaces)}, true
+	case dbusSignalInterfacesRemoved:
+		removed, ok := sig.Body[dbusInterfacesRemovedList].([]string)
+		if !ok {
+			return AdapterEvent{}, false
+		}
+		for _, iface := range removed {
+			if iface == bluezAdapter1Interface {
+				return AdapterEvent{Info: AdapterInfo{ID: adapterID(objPath)}}, true
+			}
+		}
+	}
+	return AdapterEvent{}, false
+}
+
+func parseAdapterObject(objPath dbus.ObjectPath, interfaces map[string]map[string]dbus.Variant) AdapterInfo {
+	info := parseAdapterInfo(interfaces[bluezAdapter1Interface])
+	info.ID = adapterID(objPath)
+	if advProps, ok := interfaces[bluezLEAdvertisingManager1Interface]; ok {
+		info.AdvertisingFeatures, _ = advProps["SupportedFeatures"].Value().([]string)
+		info.AdvertisingInstances, _ = advProps["SupportedInstances"].Value().(byte)
+	}
+	return info
+}
+
+// adapterID returns "hci0" for "/org/bluez/hci0".
+func adapterID(objPath dbus.ObjectPath) string {
+	return path.Base(strings.TrimSuffix(string(objPath), "/"))
+}
@@ -0,0 +1,400 @@
+// Package advdata encodes and decodes Bluetooth LE advertising data, the
+// sequence of AD structures carried in advertising and scan response PDUs.
+//
+// It does not talk to the radio, so it can be used to check payload size and
+// layout offline and to decode raw data captured by other tools.
+package advdata
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+// AD types from the Bluetooth Assigned Numbers document.
+const (
+	TypeFlags                    = 0x01
+	TypeIncomplete16BitUUIDs     = 0x02
+	TypeComplete16BitUUIDs       = 0x03
+	TypeIncomplete32BitUUIDs     = 0x04
+	TypeComplete32BitUUIDs       = 0x05
+	TypeIncomplete128BitUUIDs    = 0x06
+	TypeComplete128BitUUIDs      = 0x07
+	TypeShortenedLocalName       = 0x08
+	TypeCompleteLocalName        = 0x09
+	TypeTxPowerLevel             = 0x0A
+	TypeServiceData16BitUUID     = 0x16
+	TypeAppearance               = 0x19
+	TypeServiceData32BitUUID     = 0x20
+	TypeServiceData128BitUUID    = 0x21
+	TypeURI                      = 0x24
+	TypeLESupportedFeatures      = 0x27
+	TypeManufacturerSpecificData = 0xFF
+)
+
+// Bits of the Flags AD structure.
+const (
+	FlagLELimitedDiscoverable = 0x01
+	FlagLEGeneralDiscoverable = 0x02
+	FlagBREDRNotSupported     = 0x04
+)
+
+const (
+	// MaxLegacyLength is the advertising data size of a legacy PDU.
+	MaxLegacyLength = 31
+	// MaxExtendedLength is the largest advertising data an extended
+	// advertising set can carry once the controller fragments it.
+	MaxExtendedLength = 1650
+	// MaxElementLength is the largest data of a single AD structure, whose
+	// one byte length also counts the type.
+	MaxElementLength = 254
+)
+
+var (
+	ErrTooLarge        = errors.New("advdata: payload does not fit")
+	ErrElementTooLarge = errors.New("advdata: AD structure data longer than 254 bytes")
+	ErrMalformed       = errors.New("advdata: malformed AD structure")
+)
+
+// Element is a single AD structure.
+type Element struct {
+	Type byte
+	Data []byte
+}
+
+// Payload is the decoded form of advertising data. Zero values are left out
+// when encoding.
+type Payload struct {
+	Flags byte
+
+	LocalName string
+	// ShortName marks LocalName as a shortened name.
+	ShortName bool
+
+	ServiceUUIDs []bluetooth.UUID
+	// IncompleteUUIDs marks the UUID lists as incomplete.
+	IncompleteUUIDs bool
+
+	ServiceData      []bluetooth.ServiceDataElement
+	ManufacturerData []bluetooth.ManufacturerDataElement
+
+	TxPower    *int8
+	Appearance *uint16
+	URI        string
+
+	LESupportedFeatures []byte
+
+	// Other holds AD structures this package does not interpret.
+	Other []Element
+}
+
+// FromOptions returns the payload that advertising with options produces.
+func FromOptions(options bluetooth.AdvertisementOptions) Payload {
+	p := Payload{
+		LocalName:        options.LocalName,
+		ServiceUUIDs:     options.ServiceUUIDs,
+		ServiceData:      options.ServiceData,
+		ManufacturerData: options.ManufacturerData,
+	}
+	if options.AdvertisementType != bluetooth.AdvertisingTypeDirectInd {
+		p.Flags = FlagLEGeneralDiscoverable | FlagBREDRNotSupported
+	}
+	return p
+}
+
+// EncodeLegacy encodes options into at most 31 bytes, shortening the local
+// name if that is needed to make it fit.
+func EncodeLegacy(options bluetooth.AdvertisementOptions) ([]byte, error) {
+	return FromOptions(options).Encode(MaxLegacyLength)
+}
+
+// EncodeExtended encodes options for an extended advertising set.
+func EncodeExtended(options bluetooth.AdvertisementOptions) ([]byte, error) {
+	return FromOptions(options).Encode(MaxExtendedLength)
+}
+
+// Encode returns the AD structures of p. If the result is longer than
+// maxLength the local name is shortened; ErrTooLarge is returned when it
+// still does not fit.
+func (p Payload) Encode(maxLength int) ([]byte, error) {
+	data, err := p.appendElements(nil, false)
+	if err == nil && len(data) <= maxLength {
+		return data, nil
+	}
+	if p.LocalName == "" {
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrTooLarge
+	}
+
+	// Shorten the name to whatever space is left, keeping at least one
+	// character and never cutting a UTF-8 sequence in half.
+	withoutName, err := p.appendElements(nil, true)
+	if err != nil {
+		return nil, err
+	}
+	room := min(maxLength-len(withoutName)-2, MaxElementLength)
+	if room < 1 {
+		return nil, ErrTooLarge
+	}
+	name := p.LocalName
+	for len(name) > room {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	if name == "" {
+		return nil, ErrTooLarge
+	}
+	short := p
+	short.LocalName = name
+	short.ShortName = true
+	return short.appendElements(nil, false)
+}
+
+// Elements returns p as a list of AD structures. It fails with
+// ErrElementTooLarge if one of them cannot be encoded.
+func (p Payload) Elements() ([]Element, error) {
+	data, err := p.appendElements(nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return Split(data)
+}
+
+// elementWriter appends AD structures to buf and remembers the first
+// structure that is too long to encode.
+type elementWriter struct {
+	buf []byte
+	err error
+}
+
+func (w *elementWriter) append(typ byte, data []byte) {
+	if len(data) > MaxElementLength {
+		if w.err == nil {
+			w.err = ErrElementTooLarge
+		}
+		return
+	}
+	w.buf = append(w.buf, byte(len(data)+1), typ)
+	w.buf = append(w.buf, data...)
+}
+
+func (p Payload) appendElements(buf []byte, skipName bool) ([]byte, error) {
+	w := elementWriter{buf: buf}
+	if p.Flags != 0 {
+		w.append(TypeFlags, []byte{p.Flags})
+	}
+
+	var uuid16, uuid32, uuid128 []byte
+	for _, uuid := range p.ServiceUUIDs {
+		switch {
+		case uuid.Is16Bit():
+			uuid16 = binary.LittleEndian.AppendUint16(uuid16, uuid.Get16Bit())
+		case uuid.Is32Bit():
+			uuid32 = binary.LittleEndian.AppendUint32(uuid32, uuid.Get32Bit())
+		default:
+			b := uuid.Bytes()
+			uuid128 = append(uuid128, b[:]...)
+		}
+	}
+	var incomplete byte
+	if p.IncompleteUUIDs {
+		incomplete = 1
+	}
+	if len(uuid16) != 0 {
+		w.append(TypeComplete16BitUUIDs-incomplete, uuid16)
+	}
+	if len(uuid32) != 0 {
+		w.append(TypeComplete32BitUUIDs-incomplete, uuid32)
+	}
+	if len(uuid128) != 0 {
+		w.append(TypeComplete128BitUUIDs-incomplete, uuid128)
+	}
+
+	for _, element := range p.ServiceData {
+		var data []byte
+		var typ byte
+		switch {
+		case element.UUID.Is16Bit():
+			typ = TypeServiceData16BitUUID
+			data = binary.LittleEndian.AppendUint16(data, element.UUID.Get16Bit())
+		case element.UUID.Is32Bit():
+			typ = TypeServiceData32BitUUID
+			data = binary.LittleEndian.AppendUint32(data, element.UUID.Get32Bit())
+		default:
+			typ = TypeServiceData128BitUUID
+			b := element.UUID.Bytes()
+			data = append(data, b[:]...)
+		}
+		w.append(typ, append(data, element.Data...))
+	}
+
+	if p.TxPower != nil {
+		w.append(TypeTxPowerLevel, []byte{byte(*p.TxPower)})
+	}
+	if p.Appearance != nil {
+		w.append(TypeAppearance, binary.LittleEndian.AppendUint16(nil, *p.Appearance))
+	}
+	if p.URI != "" {
+		w.append(TypeURI, encodeURI(p.URI))
+	}
+	if len(p.LESupportedFeatures) != 0 {
+		w.append(TypeLESupportedFeatures, p.LESupportedFeatures)
+	}
+
+	for _, element := range p.ManufacturerData {
+		data := binary.LittleEndian.AppendUint16(nil, element.CompanyID)
+		w.append(TypeManufacturerSpecificData, append(data, element.Data...))
+	}
+
+	for _, element := range p.Other {
+		w.append(element.Type, element.Data)
+	}
+
+	// The name goes last so that a truncating scanner loses it first.
+	if p.LocalName != "" && !skipName {
+		typ := byte(TypeCompleteLocalName)
+		if p.ShortName {
+			typ = TypeShortenedLocalName
+		}
+		w.append(typ, []byte(p.LocalName))
+	}
+	return w.buf, w.err
+}
+
+// URI scheme name string codes from the Assigned Numbers document. Code
+// 0x01 means the scheme is not compressed.
+var uriSchemes = []struct {
+	code   rune
+	scheme string
+}{
+	{0x16, "http:"},
+	{0x17, "https:"},
+}
+
+func encodeURI(uri string) []byte {
+	for _, s := range uriSchemes {
+		if strings.HasPrefix(uri, s.scheme) {
+			return append(utf8.AppendRune(nil, s.code), uri[len(s.scheme):]...)
+		}
+	}
+	return append([]byte{0x01}, uri...)
+}
+
+func decodeURI(data []byte) string {
+	code, size := utf8.DecodeRune(data)
+	if code == utf8.RuneError {
+		return string(data)
+	}
+	for _, s := range uriSchemes {
+		if s.code == code {
+			return s.scheme + string(data[size:])
+		}
+	}
+	return string(data[size:])
+}
+
+// Split breaks raw advertising data into AD structures. A zero length byte
+// ends the data, as used for padding by some controllers.
+func Split(data []byte) ([]Element, error) {
+	var elements []Element
+	for len(data) > 0 {
+		length := int(data[0])
+		if length == 0 {
+			break
+		}
+		if length+1 > len(data) {
+			return elements, ErrMalformed
+		}
+		elements = append(elements, Element{Type: data[1], Data: data[2 : length+1]})
+		data = data[length+1:]
+	}
+	return elements, nil
+}
+
+// Parse decodes raw advertising data. Structures with an invalid length for
+// their type are returned in Other.
+func Parse(data []byte) (Payload, error) {
+	elements, err := Split(data)
+	var p Payload
+	for _, element := range elements {
+		if !p.decodeElement(element) {
+			p.Other = append(p.Other, element)
+		}
+	}
+	return p, err
+}
+
+func (p *Payload) decodeElement(element Element) bool {
+	data := element.Data
+	switch element.Type {
+	case TypeFlags:
+		if len(data) < 1 {
+			return false
+		}
+		p.Flags = data[0]
+	case TypeIncomplete16BitUUIDs, TypeComplete16BitUUIDs:
+		return p.decodeUUIDs(data, 2, element.Type == TypeIncomplete16BitUUIDs)
+	case TypeIncomplete32BitUUIDs, TypeComplete32BitUUIDs:
+		return p.decodeUUIDs(data, 4, element.Type == TypeIncomplete32BitUUIDs)
+	case TypeIncomplete128BitUUIDs, TypeComplete128BitUUIDs:
+		return p.decodeUUIDs(data, 16, element.Type == TypeIncomplete128BitUUIDs)
+	case TypeShortenedLocalName, TypeCompleteLocalName:
+		p.LocalName = string(data)
+		p.ShortName = element.Type == TypeShortenedLocalName
+	case TypeTxPowerLevel:
+		if len(data) != 1 {
+			return false
+		}
+		txPower := int8(data[0])
+		p.TxPower = &txPower
+	case TypeAppearance:
+		if len(data) != 2 {
+			return false
+		}
+		appearance := binary.LittleEndian.Uint16(data)
+		p.Appearance = &appearance
+	case TypeServiceData16BitUUID, TypeServiceData32BitUUID, TypeServiceData128BitUUID:
+		size := map[byte]int{TypeServiceData16BitUUID: 2, TypeServiceData32BitUUID: 4, TypeServiceData128BitUUID: 16}[element.Type]
+		if len(data) < size {
+			return false
+		}
+		var uuid bluetooth.UUID
+		uuid.UnmarshalBinary(data[:size])
+		p.ServiceData = append(p.ServiceData, bluetooth.ServiceDataElement{UUID: uuid, Data: data[size:]})
+	case TypeURI:
+		p.URI = decodeURI(data)
+	case TypeLESupportedFeatures:
+		p.LESupportedFeatures = data
+	case TypeManufacturerSpecificData:
+		if len(data) < 2 {
+			return false
+		}
+		p.ManufacturerData = append(p.ManufacturerData, bluetooth.ManufacturerDataElement{
+			CompanyID: binary.LittleEndian.Uint16(data),
+			Data:      data[2:],
+		})
+	default:
+		return false
+	}
+	return true
+}
+
+func (p *Payload) decodeUUIDs(data []byte, size int, incomplete bool) bool {
+	if len(data)%size != 0 {
+		return false
+	}
+	for i := 0; i < len(data); i += size {
+		var uuid bluetooth.UUID
+		uuid.UnmarshalBinary(data[i : i+size])
+		p.ServiceUUIDs = append(p.ServiceUUIDs, uuid)
+	}
+	if incomplete {
+		p.IncompleteUUIDs = true
+	}
+	return true
+}
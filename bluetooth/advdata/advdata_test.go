@@ -0,0 +1,190 @@
+package advdata
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+func TestEncode(t *testing.T) {
+	txPower := int8(-4)
+	tests := []struct {
+		name    string
+		payload Payload
+		want    []byte
+	}{
+		{
+			name:    "flags",
+			payload: Payload{Flags: FlagLEGeneralDiscoverable | FlagBREDRNotSupported},
+			want:    []byte{0x02, TypeFlags, 0x06},
+		},
+		{
+			name:    "16-bit UUIDs",
+			payload: Payload{ServiceUUIDs: []bluetooth.UUID{bluetooth.New16BitUUID(0x180D), bluetooth.New16BitUUID(0x180F)}},
+			want:    []byte{0x05, TypeComplete16BitUUIDs, 0x0D, 0x18, 0x0F, 0x18},
+		},
+		{
+			name:    "incomplete 16-bit UUIDs",
+			payload: Payload{ServiceUUIDs: []bluetooth.UUID{bluetooth.New16BitUUID(0x180D)}, IncompleteUUIDs: true},
+			want:    []byte{0x03, TypeIncomplete16BitUUIDs, 0x0D, 0x18},
+		},
+		{
+			name:    "service data",
+			payload: Payload{ServiceData: []bluetooth.ServiceDataElement{{UUID: bluetooth.New16BitUUID(0xFEAA), Data: []byte{0x10, 0x20}}}},
+			want:    []byte{0x05, TypeServiceData16BitUUID, 0xAA, 0xFE, 0x10, 0x20},
+		},
+		{
+			name:    "manufacturer data",
+			payload: Payload{ManufacturerData: []bluetooth.ManufacturerDataElement{{CompanyID: 0x004C, Data: []byte{0x02, 0x15}}}},
+			want:    []byte{0x05, TypeManufacturerSpecificData, 0x4C, 0x00, 0x02, 0x15},
+		},
+		{
+			name:    "tx power",
+			payload: Payload{TxPower: &txPower},
+			want:    []byte{0x02, TypeTxPowerLevel, 0xFC},
+		},
+		{
+			name:    "URI",
+			payload: Payload{URI: "https://example.com"},
+			want:    append([]byte{0x0F, TypeURI, 0x17}, "//example.com"...),
+		},
+		{
+			name:    "name last",
+			payload: Payload{LocalName: "abc", Flags: FlagLEGeneralDiscoverable},
+			want:    []byte{0x02, TypeFlags, 0x02, 0x04, TypeCompleteLocalName, 'a', 'b', 'c'},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.payload.Encode(MaxLegacyLength)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("Encode() = % x, want % x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeShortensName(t *testing.T) {
+	p := Payload{Flags: FlagLEGeneralDiscoverable, LocalName: strings.Repeat("é", 20)}
+	data, err := p.Encode(MaxLegacyLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) > MaxLegacyLength {
+		t.Fatalf("encoded %d bytes, want at most %d", len(data), MaxLegacyLength)
+	}
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.ShortName || got.LocalName != strings.Repeat("é", 13) {
+		t.Errorf("got name %q (short %v), want 13 runes marked as short", got.LocalName, got.ShortName)
+	}
+
+	// A name that would overflow the length byte is shortened as well.
+	p = Payload{LocalName: strings.Repeat("x", 300)}
+	data, err = p.Encode(MaxExtendedLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data[0] != MaxElementLength+1 || data[1] != TypeShortenedLocalName {
+		t.Errorf("got header % x, want %02x %02x", data[:2], MaxElementLength+1, TypeShortenedLocalName)
+	}
+}
+
+func TestEncodeTooLarge(t *testing.T) {
+	p := Payload{ManufacturerData: []bluetooth.ManufacturerDataElement{{CompanyID: 0xFFFF, Data: make([]byte, 30)}}}
+	if _, err := p.Encode(MaxLegacyLength); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Encode() error = %v, want %v", err, ErrTooLarge)
+	}
+
+	for _, size := range []int{253, 255, 1000} {
+		p := Payload{Other: []Element{{Type: 0x2A, Data: make([]byte, size)}}}
+		_, err := p.Encode(MaxExtendedLength)
+		if size <= MaxElementLength {
+			if err != nil {
+				t.Errorf("%d bytes: Encode() error = %v", size, err)
+			}
+		} else if !errors.Is(err, ErrElementTooLarge) {
+			t.Errorf("%d bytes: Encode() error = %v, want %v", size, err, ErrElementTooLarge)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	txPower := int8(-12)
+	appearance := uint16(0x00C1)
+	uuid128, err := bluetooth.ParseUUID("6e400001-b5a3-f393-e0a9-e50e24dcca9e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Payload{
+		Flags:        FlagLEGeneralDiscoverable | FlagBREDRNotSupported,
+		LocalName:    "sensor",
+		ServiceUUIDs: []bluetooth.UUID{bluetooth.New16BitUUID(0x180F), bluetooth.New32BitUUID(0x12345678), uuid128},
+		ServiceData: []bluetooth.ServiceDataElement{
+			{UUID: bluetooth.New16BitUUID(0x180F), Data: []byte{80}},
+			{UUID: uuid128, Data: []byte{1, 2, 3}},
+		},
+		ManufacturerData: []bluetooth.ManufacturerDataElement{{CompanyID: 0x0059, Data: []byte{0xAB}}},
+		TxPower:          &txPower,
+		Appearance:       &appearance,
+		URI:              "http://example.org/x",
+		Other:            []Element{{Type: 0x2A, Data: []byte{9}}},
+	}
+	data, err := want.Encode(MaxExtendedLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse(Encode(p)) = %+v, want %+v", got, want)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want []Element
+		err  error
+	}{
+		{"empty", nil, nil, nil},
+		{"padding", []byte{0x02, 0x01, 0x06, 0x00, 0x00}, []Element{{Type: 0x01, Data: []byte{0x06}}}, nil},
+		{"truncated", []byte{0x02, 0x01, 0x06, 0x05, 0x09, 'a'}, []Element{{Type: 0x01, Data: []byte{0x06}}}, ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Split(tt.data)
+			if err != tt.err {
+				t.Errorf("Split() error = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInvalidLengths(t *testing.T) {
+	// A 16-bit UUID list with an odd length and a three byte appearance are
+	// kept as unknown structures.
+	data := []byte{0x04, TypeComplete16BitUUIDs, 0x0D, 0x18, 0x0F, 0x04, TypeAppearance, 1, 2, 3}
+	got, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.ServiceUUIDs) != 0 || got.Appearance != nil || len(got.Other) != 2 {
+		t.Errorf("Parse() = %+v, want two unknown structures", got)
+	}
+}
@@ -0,0 +1,209 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/godbus/dbus/v5"
+)
+
+var agentID uint64
+
+// AgentCapability is the IO capability announced to BlueZ. It decides which
+// pairing method is used.
+type AgentCapability string
+
+const (
+	AgentNoInputNoOutput AgentCapability = "NoInputNoOutput"
+	AgentDisplayOnly     AgentCapability = "DisplayOnly"
+	AgentDisplayYesNo    AgentCapability = "DisplayYesNo"
+	AgentKeyboardOnly    AgentCapability = "KeyboardOnly"
+	AgentKeyboardDisplay AgentCapability = "KeyboardDisplay"
+)
+
+// AgentHandlers are the callbacks invoked during pairing. A nil callback
+// makes the agent reject the corresponding request.
+type AgentHandlers struct {
+	// RequestPasskey asks for the 6-digit passkey shown on the remote device.
+	RequestPasskey func(device Device) (uint32, error)
+	// DisplayPasskey shows the passkey that has to be typed on the remote
+	// device. entered is the number of digits typed so far.
+	DisplayPasskey func(device Device, passkey uint32, entered uint16)
+	// RequestConfirmation asks whether passkey matches the remote device.
+	RequestConfirmation func(device Device, passkey uint32) error
+	// RequestAuthorization asks whether an incoming pairing is accepted.
+	RequestAuthorization func(device Device) error
+	// AuthorizeService asks whether device may use the service uuid.
+	AuthorizeService func(device Device, uuid UUID) error
+	// Cancel is called when BlueZ aborts the current request.
+	Cancel func()
+}
+
+// Agent handles pairing requests on behalf of BlueZ.
+type Agent struct {
+	adapter    *Adapter
+	path       dbus.ObjectPath
+	capability AgentCapability
+	handlers   AgentHandlers
+	registered bool
+
+	// lookup returns the device a request is for. Tests replace it to
+	// avoid a D-Bus connection.
+	lookup func(path dbus.ObjectPath) Device
+}
+
+// NewAgent creates an agent for the adapter. It must be registered before
+// BlueZ routes pairing requests to it.
+func (a *Adapter) NewAgent(capability AgentCapability, handlers AgentHandlers) *Agent {
+	id := atomic.AddUint64(&agentID, 1)
+	ag := &Agent{
+		adapter:    a,
+		path:       dbus.ObjectPath(fmt.Sprintf("/org/nbable/bluetooth/agent%d", id)),
+		capability: capability,
+		handlers:   handlers,
+	}
+	ag.lookup = ag.device
+	return ag
+}
+
+// Register exports the agent and registers it with AgentManager1. If
+// makeDefault is set the agent also handles requests not started by this
+// process, such as a phone initiating pairing.
+func (ag *Agent) Register(ctx context.Context, makeDefault bool) error {
+	if err := ag.adapter.bus.Export(agentObject{ag}, ag.path, "org.bluez.Agent1"); err != nil {
+		return err
+	}
+
+	manager := ag.adapter.bus.Object("org.bluez", dbus.ObjectPath("/org/bluez"))
+	err := manager.CallWithContext(ctx, "org.bluez.AgentManager1.RegisterAgent", 0, ag.path, string(ag.capability)).Err
+	if err != nil {
+		ag.adapter.bus.Export(nil, ag.path, "org.bluez.Agent1")
+		return fmt.Errorf("bluetooth: could not register agent: %w", wrapError(err))
+	}
+	ag.registered = true
+
+	if makeDefault {
+		err := manager.CallWithContext(ctx, "org.bluez.AgentManager1.RequestDefaultAgent", 0, ag.path).Err
+		if err != nil {
+			// Do not leave a registered agent behind that the caller
+			// does not know about.
+			manager.CallWithContext(ctx, "org.bluez.AgentManager1.UnregisterAgent", 0, ag.path)
+			ag.adapter.bus.Export(nil, ag.path, "org.bluez.Agent1")
+			ag.registered = false
+			return fmt.Errorf("bluetooth: could not make agent default: %w", wrapError(err))
+		}
+	}
+	return nil
+}
+
+// Unregister removes the agent from BlueZ.
+func (ag *Agent) Unregister(ctx context.Context) error {
+	if !ag.registered {
+		return nil
+	}
+	manager := ag.adapter.bus.Object("org.bluez", dbus.ObjectPath("/org/bluez"))
+	err := manager.CallWithContext(ctx, "org.bluez.AgentManager1.UnregisterAgent", 0, ag.path).Err
+	ag.adapter.bus.Export(nil, ag.path, "org.bluez.Agent1")
+	ag.registered = false
+	if err != nil {
+		return fmt.Errorf("bluetooth: could not unregister agent: %w", wrapError(err))
+	}
+	return nil
+}
+
+func (ag *Agent) device(path dbus.ObjectPath) Device {
+	device := Device{
+		device:  ag.adapter.bus.Object("org.bluez", path),
+		adapter: ag.adapter,
+	}
+	var props map[string]dbus.Variant
+	if err := device.device.Call("org.freedesktop.DBus.Properties.GetAll", 0, bluezDevice1Interface).Store(&props); err == nil {
+		device.parseProperties(&props)
+	}
+	return device
+}
+
+// agentObject is exported on D-Bus as org.bluez.Agent1. It is kept separate
+// from Agent so that only the interface methods show up on the bus.
+type agentObject struct {
+	ag *Agent
+}
+
+var (
+	errAgentRejected = dbus.NewError("org.bluez.Error.Rejected", nil)
+	errAgentCanceled = dbus.NewError("org.bluez.Error.Canceled", nil)
+)
+
+// agentError converts a callback error into the reply BlueZ expects.
+func agentError(err error) *dbus.Error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, ErrCanceled) {
+		return errAgentCanceled
+	}
+	return dbus.NewError("org.bluez.Error.Rejected", []interface{}{err.Error()})
+}
+
+func (o agentObject) Release() *dbus.Error {
+	o.ag.registered = false
+	return nil
+}
+
+func (o agentObject) RequestPinCode(device dbus.ObjectPath) (string, *dbus.Error) {
+	// Legacy PIN pairing is not used by LE devices.
+	return "", errAgentRejected
+}
+
+func (o agentObject) DisplayPinCode(device dbus.ObjectPath, pincode string) *dbus.Error {
+	return errAgentRejected
+}
+
+func (o agentObject) RequestPasskey(device dbus.ObjectPath) (uint32, *dbus.Error) {
+	if o.ag.handlers.RequestPasskey == nil {
+		return 0, errAgentRejected
+	}
+	passkey, err := o.ag.handlers.RequestPasskey(o.ag.lookup(device))
+	return passkey, agentError(err)
+}
+
+func (o agentObject) DisplayPasskey(device dbus.ObjectPath, passkey uint32, entered uint16) *dbus.Error {
+	if o.ag.handlers.DisplayPasskey != nil {
+		o.ag.handlers.DisplayPasskey(o.ag.lookup(device), passkey, entered)
+	}
+	return nil
+}
+
+func (o agentObject) RequestConfirmation(device dbus.ObjectPath, passkey uint32) *dbus.Error {
+	if o.ag.handlers.RequestConfirmation == nil {
+		return errAgentRejected
+	}
+	return agentError(o.ag.handlers.RequestConfirmation(o.ag.lookup(device), passkey))
+}
+
+func (o agentObject) RequestAuthorization(device dbus.ObjectPath) *dbus.Error {
+	if o.ag.handlers.RequestAuthorization == nil {
+		return errAgentRejected
+	}
+	return agentError(o.ag.handlers.RequestAuthorization(o.ag.lookup(device)))
+}
+
+func (o agentObject) AuthorizeService(device dbus.ObjectPath, uuid string) *dbus.Error {
+	if o.ag.handlers.AuthorizeService == nil {
+		return errAgentRejected
+	}
+	serviceUUID, err := ParseUUID(uuid)
+	if err != nil {
+		return errAgentRejected
+	}
+	return agentError(o.ag.handlers.AuthorizeService(o.ag.lookup(device), serviceUUID))
+}
+
+func (o agentObject) Cancel() *dbus.Error {
+	if o.ag.handlers.Cancel != nil {
+		o.ag.handlers.Cancel()
+	}
+	return nil
+}
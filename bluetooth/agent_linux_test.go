@@ -0,0 +1,179 @@
+package bluetooth
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const testDevicePath = dbus.ObjectPath("/org/bluez/hci0/dev_AA_BB_CC_DD_EE_FF")
+
+// testAgent returns an agent whose requests go to handlers without a D-Bus
+// connection, and records the device each handler was called for.
+func testAgent(handlers AgentHandlers) (agentObject, *[]dbus.ObjectPath) {
+	var paths []dbus.ObjectPath
+	ag := &Agent{handlers: handlers, registered: true}
+	ag.lookup = func(path dbus.ObjectPath) Device {
+		paths = append(paths, path)
+		return Device{}
+	}
+	return agentObject{ag}, &paths
+}
+
+func errorName(err *dbus.Error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Name
+}
+
+func TestAgentDispatch(t *testing.T) {
+	const (
+		rejected = "org.bluez.Error.Rejected"
+		canceled = "org.bluez.Error.Canceled"
+	)
+	var gotPasskey uint32
+	var gotUUID UUID
+	var cancels int
+	accept := AgentHandlers{
+		RequestPasskey: func(device Device) (uint32, error) { return 123456, nil },
+		DisplayPasskey: func(device Device, passkey uint32, entered uint16) { gotPasskey = passkey },
+		RequestConfirmation: func(device Device, passkey uint32) error {
+			gotPasskey = passkey
+			return nil
+		},
+		RequestAuthorization: func(device Device) error { return nil },
+		AuthorizeService: func(device Device, uuid UUID) error {
+			gotUUID = uuid
+			return nil
+		},
+		Cancel: func() { cancels++ },
+	}
+	refuse := AgentHandlers{
+		RequestPasskey:       func(device Device) (uint32, error) { return 0, ErrCanceled },
+		RequestConfirmation:  func(device Device, passkey uint32) error { return errors.New("no match") },
+		RequestAuthorization: func(device Device) error { return fmt.Errorf("user said no: %w", ErrCanceled) },
+		AuthorizeService:     func(device Device, uuid UUID) error { return errors.New("not allowed") },
+	}
+
+	tests := []struct {
+		name     string
+		handlers AgentHandlers
+		call     func(o agentObject) *dbus.Error
+		want     string
+		// lookups is the number of device lookups the call makes.
+		lookups int
+	}{
+		{"pin code", accept, func(o agentObject) *dbus.Error {
+			_, err := o.RequestPinCode(testDevicePath)
+			return err
+		}, rejected, 0},
+		{"display pin code", accept, func(o agentObject) *dbus.Error {
+			return o.DisplayPinCode(testDevicePath, "0000")
+		}, rejected, 0},
+		{"passkey", accept, func(o agentObject) *dbus.Error {
+			passkey, err := o.RequestPasskey(testDevicePath)
+			if passkey != 123456 {
+				t.Errorf("RequestPasskey() = %d, want 123456", passkey)
+			}
+			return err
+		}, "", 1},
+		{"passkey canceled", refuse, func(o agentObject) *dbus.Error {
+			_, err := o.RequestPasskey(testDevicePath)
+			return err
+		}, canceled, 1},
+		{"passkey without handler", AgentHandlers{}, func(o agentObject) *dbus.Error {
+			_, err := o.RequestPasskey(testDevicePath)
+			return err
+		}, rejected, 0},
+		{"display passkey", accept, func(o agentObject) *dbus.Error {
+			return o.DisplayPasskey(testDevicePath, 42, 3)
+		}, "", 1},
+		{"display passkey without handler", AgentHandlers{}, func(o agentObject) *dbus.Error {
+			return o.DisplayPasskey(testDevicePath, 42, 3)
+		}, "", 0},
+		{"confirmation", accept, func(o agentObject) *dbus.Error {
+			return o.RequestConfirmation(testDevicePath, 654321)
+		}, "", 1},
+		{"confirmation refused", refuse, func(o agentObject) *dbus.Error {
+			return o.RequestConfirmation(testDevicePath, 654321)
+		}, rejected, 1},
+		{"confirmation without handler", AgentHandlers{}, func(o agentObject) *dbus.Error {
+			return o.RequestConfirmation(testDevicePath, 654321)
+		}, rejected, 0},
+		{"authorization", accept, func(o agentObject) *dbus.Error {
+			return o.RequestAuthorization(testDevicePath)
+		}, "", 1},
+		{"authorization canceled", refuse, func(o agentObject) *dbus.Error {
+			return o.RequestAuthorization(testDevicePath)
+		}, canceled, 1},
+		{"authorization without handler", AgentHandlers{}, func(o agentObject) *dbus.Error {
+			return o.RequestAuthorization(testDevicePath)
+		}, rejected, 0},
+		{"service", accept, func(o agentObject) *dbus.Error {
+			return o.AuthorizeService(testDevicePath, "0000180f-0000-1000-8000-00805f9b34fb")
+		}, "", 1},
+		{"service refused", refuse, func(o agentObject) *dbus.Error {
+			return o.AuthorizeService(testDevicePath, "0000180f-0000-1000-8000-00805f9b34fb")
+		}, rejected, 1},
+		{"service with bad UUID", accept, func(o agentObject) *dbus.Error {
+			return o.AuthorizeService(testDevicePath, "battery")
+		}, rejected, 0},
+		{"service without handler", AgentHandlers{}, func(o agentObject) *dbus.Error {
+			return o.AuthorizeService(testDevicePath, "0000180f-0000-1000-8000-00805f9b34fb")
+		}, rejected, 0},
+		{"cancel without handler", AgentHandlers{}, func(o agentObject) *dbus.Error {
+			return o.Cancel()
+		}, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, paths := testAgent(tt.handlers)
+			if got := errorName(tt.call(o)); got != tt.want {
+				t.Errorf("error = %q, want %q", got, tt.want)
+			}
+			if len(*paths) != tt.lookups {
+				t.Errorf("%d device lookups, want %d", len(*paths), tt.lookups)
+			}
+			for _, path := range *paths {
+				if path != testDevicePath {
+					t.Errorf("looked up %s, want %s", path, testDevicePath)
+				}
+			}
+		})
+	}
+
+	o, _ := testAgent(accept)
+	o.DisplayPasskey(testDevicePath, 42, 3)
+	if gotPasskey != 42 {
+		t.Errorf("DisplayPasskey handler got %d, want 42", gotPasskey)
+	}
+	o.RequestConfirmation(testDevicePath, 654321)
+	if gotPasskey != 654321 {
+		t.Errorf("RequestConfirmation handler got %d, want 654321", gotPasskey)
+	}
+	o.AuthorizeService(testDevicePath, "0000180f-0000-1000-8000-00805f9b34fb")
+	if gotUUID != New16BitUUID(0x180F) {
+		t.Errorf("AuthorizeService handler got %s, want %s", gotUUID, New16BitUUID(0x180F))
+	}
+	o.Cancel()
+	if cancels != 1 {
+		t.Errorf("Cancel handler called %d times, want 1", cancels)
+	}
+	o.Release()
+	if o.ag.registered {
+		t.Error("agent still registered after Release")
+	}
+}
+
+func TestAgentErrorMessage(t *testing.T) {
+	err := agentError(errors.New("wrong passkey"))
+	if err.Name != "org.bluez.Error.Rejected" || len(err.Body) != 1 || err.Body[0] != "wrong passkey" {
+		t.Errorf("agentError() = %s %v, want Rejected with the message", err.Name, err.Body)
+	}
+	if agentError(nil) != nil {
+		t.Error("agentError(nil) != nil")
+	}
+}
@@ -0,0 +1,63 @@
+// Package assignednumbers maps Bluetooth SIG assigned numbers to their
+// names: 16-bit service, characteristic, descriptor and member UUIDs,
+// company identifiers and appearance values.
+//
+// The tables are generated from a vendored copy of the SIG assigned numbers
+// YAML in the yaml directory; yaml/README.md records where the files come
+// from. To update them, replace the files there with newer ones and run go
+// generate.
+package assignednumbers
+
+//go:generate go run ./internal/gen -dir yaml -o zz_generated.go
+
+// ServiceName returns the name of a 16-bit service UUID.
+func ServiceName(uuid uint16) (string, bool) {
+	name, ok := services[uuid]
+	return name, ok
+}
+
+// CharacteristicName returns the name of a 16-bit characteristic UUID.
+func CharacteristicName(uuid uint16) (string, bool) {
+	name, ok := characteristics[uuid]
+	return name, ok
+}
+
+// DescriptorName returns the name of a 16-bit descriptor UUID.
+func DescriptorName(uuid uint16) (string, bool) {
+	name, ok := descriptors[uuid]
+	return name, ok
+}
+
+// MemberName returns the company a 16-bit member UUID is assigned to.
+func MemberName(uuid uint16) (string, bool) {
+	name, ok := members[uuid]
+	return name, ok
+}
+
+// UUIDName looks up a 16-bit UUID in all UUID tables.
+func UUIDName(uuid uint16) (string, bool) {
+	for _, table := range []map[uint16]string{services, characteristics, descriptors, members} {
+		if name, ok := table[uuid]; ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// CompanyName returns the name of a company identifier, as used in
+// manufacturer specific advertising data.
+func CompanyName(id uint16) (string, bool) {
+	name, ok := companies[id]
+	return name, ok
+}
+
+// AppearanceName returns the name of an appearance value. The upper 10 bits
+// are the category and the lower 6 bits the subcategory. If the subcategory
+// is unknown the category name is returned.
+func AppearanceName(appearance uint16) (string, bool) {
+	if name, ok := appearanceSubcategories[appearance]; ok {
+		return name, true
+	}
+	name, ok := appearanceCategories[appearance>>6]
+	return name, ok
+}
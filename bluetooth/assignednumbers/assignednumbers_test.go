@@ -0,0 +1,38 @@
+package assignednumbers
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(uint16) (string, bool)
+		id     uint16
+		want   string
+	}{
+		{"ServiceName", ServiceName, 0x180F, "Battery"},
+		{"ServiceName", ServiceName, 0x180D, "Heart Rate"},
+		{"CharacteristicName", CharacteristicName, 0x2A19, "Battery Level"},
+		{"DescriptorName", DescriptorName, 0x2902, "Client Characteristic Configuration"},
+		{"MemberName", MemberName, 0xFEAA, "Google LLC"},
+		{"UUIDName", UUIDName, 0x2A19, "Battery Level"},
+		{"CompanyName", CompanyName, 0x004C, "Apple, Inc."},
+		{"AppearanceName", AppearanceName, 0x00C1, "Sports Watch"},
+		// An unknown subcategory falls back to the category.
+		{"AppearanceName", AppearanceName, 0x00C5, "Watch"},
+	}
+	for _, tt := range tests {
+		got, ok := tt.lookup(tt.id)
+		if !ok || got != tt.want {
+			t.Errorf("%s(%#04x) = %q, %v, want %q", tt.name, tt.id, got, ok, tt.want)
+		}
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	if name, ok := UUIDName(0x1700); ok {
+		t.Errorf("UUIDName(0x1700) = %q, want not found", name)
+	}
+	if name, ok := ServiceName(0x2A19); ok {
+		t.Errorf("ServiceName(0x2A19) = %q, want not found", name)
+	}
+}
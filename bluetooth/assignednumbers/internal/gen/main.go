@@ -0,0 +1,217 @@
+// Command gen turns the vendored Bluetooth SIG assigned numbers YAML files
+// into Go lookup tables.
+//
+// The SIG files only use a small part of YAML: a top level key holding a
+// list of flat mappings, where appearance categories carry one more nested
+// list. The parser below handles exactly that so the generator needs nothing
+// outside the standard library.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type entry struct {
+	fields   map[string]string
+	children []entry
+}
+
+type line struct {
+	indent int
+	text   string
+}
+
+func readLines(path string) ([]line, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []line
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		raw := scanner.Text()
+		text := strings.TrimSpace(raw)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		lines = append(lines, line{indent: len(raw) - len(strings.TrimLeft(raw, " ")), text: text})
+	}
+	return lines, scanner.Err()
+}
+
+// parseList parses the list items starting at lines[i] that share the
+// indentation of the first "- " item. It returns the index after the list.
+func parseList(lines []line, i int) ([]entry, int, error) {
+	if i >= len(lines) || !strings.HasPrefix(lines[i].text, "- ") {
+		return nil, i, nil
+	}
+	itemIndent := lines[i].indent
+	var entries []entry
+	for i < len(lines) && lines[i].indent == itemIndent && strings.HasPrefix(lines[i].text, "- ") {
+		e := entry{fields: map[string]string{}}
+		keyIndent := itemIndent + 2
+		text := strings.TrimPrefix(lines[i].text, "- ")
+		for {
+			key, value, ok := strings.Cut(text, ":")
+			if !ok {
+				return nil, i, fmt.Errorf("line %q: expected key: value", lines[i].text)
+			}
+			key = strings.TrimSpace(key)
+			value = strings.TrimSpace(value)
+			i++
+			if value == "" {
+				children, next, err := parseList(lines, i)
+				if err != nil {
+					return nil, i, err
+				}
+				e.children = children
+				i = next
+			} else {
+				e.fields[key] = unquote(value)
+			}
+			if i >= len(lines) || lines[i].indent != keyIndent || strings.HasPrefix(lines[i].text, "- ") {
+				break
+			}
+			text = lines[i].text
+		}
+		entries = append(entries, e)
+	}
+	return entries, i, nil
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'")
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		if u, err := strconv.Unquote(s); err == nil {
+			return u
+		}
+	}
+	return s
+}
+
+func parseFile(path string) ([]entry, error) {
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 || !strings.HasSuffix(lines[0].text, ":") {
+		return nil, fmt.Errorf("%s: expected a top level key", path)
+	}
+	entries, next, err := parseList(lines, 1)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if next != len(lines) {
+		return nil, fmt.Errorf("%s: unexpected line %q", path, lines[next].text)
+	}
+	return entries, nil
+}
+
+func parseNumber(s string) (uint64, error) {
+	return strconv.ParseUint(s, 0, 32)
+}
+
+// writeTable writes a map literal from the numeric key field to the name.
+func writeTable(buf *bytes.Buffer, name, keyType string, entries []entry, keyField string) error {
+	type row struct {
+		key  uint64
+		name string
+	}
+	var rows []row
+	for _, e := range entries {
+		key, err := parseNumber(e.fields[keyField])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		rows = append(rows, row{key, e.fields["name"]})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].key < rows[j].key })
+
+	fmt.Fprintf(buf, "var %s = map[%s]string{\n", name, keyType)
+	for _, r := range rows {
+		fmt.Fprintf(buf, "\t0x%04X: %q,\n", r.key, r.name)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+	return nil
+}
+
+func main() {
+	out := flag.String("o", "zz_generated.go", "output file")
+	dir := flag.String("dir", "yaml", "directory with the SIG YAML files")
+	pkg := flag.String("pkg", "assignednumbers", "package name")
+	flag.Parse()
+
+	tables := []struct {
+		file, name, keyType, keyField string
+	}{
+		{"service_uuids.yaml", "services", "uint16", "uuid"},
+		{"characteristic_uuids.yaml", "characteristics", "uint16", "uuid"},
+		{"descriptors.yaml", "descriptors", "uint16", "uuid"},
+		{"member_uuids.yaml", "members", "uint16", "uuid"},
+		{"company_identifiers.yaml", "companies", "uint16", "value"},
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by internal/gen from the Bluetooth SIG assigned numbers; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", *pkg)
+
+	for _, t := range tables {
+		entries, err := parseFile(filepath.Join(*dir, t.file))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeTable(&buf, t.name, t.keyType, entries, t.keyField); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	categories, err := parseFile(filepath.Join(*dir, "appearance_values.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := writeTable(&buf, "appearanceCategories", "uint16", categories, "category"); err != nil {
+		log.Fatal(err)
+	}
+	var subcategories []entry
+	for _, c := range categories {
+		category, err := parseNumber(c.fields["category"])
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, sub := range c.children {
+			value, err := parseNumber(sub.fields["value"])
+			if err != nil {
+				log.Fatal(err)
+			}
+			subcategories = append(subcategories, entry{fields: map[string]string{
+				"value": strconv.FormatUint(category<<6|value, 10),
+				"name":  sub.fields["name"],
+			}})
+		}
+	}
+	if err := writeTable(&buf, "appearanceSubcategories", "uint16", subcategories, "value"); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
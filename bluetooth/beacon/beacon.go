@@ -0,0 +1,272 @@
+// Package beacon builds advertisements for the common beacon formats:
+// iBeacon, Eddystone (UID, URL and TLM) and AltBeacon.
+package beacon
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+const (
+	// AppleCompanyID is the company identifier iBeacon frames are sent with.
+	AppleCompanyID = 0x004C
+	// RadiusNetworksCompanyID is the default AltBeacon manufacturer.
+	RadiusNetworksCompanyID = 0x0118
+
+	iBeaconType   = 0x02
+	iBeaconLength = 0x15
+
+	eddystoneUUID  = 0xFEAA
+	eddystoneUID   = 0x00
+	eddystoneURL   = 0x10
+	eddystoneTLM   = 0x20
+	eddystoneEID   = 0x30
+	eddystoneTLMv0 = 0x00
+
+	altBeaconCode = 0xBEAC
+
+	// maxEddystoneURLLength is the encoded URL space left in a legacy PDU.
+	maxEddystoneURLLength = 17
+)
+
+var (
+	ErrURLTooLong = errors.New("beacon: URL too long for Eddystone-URL")
+	ErrURLScheme  = errors.New("beacon: Eddystone-URL needs an http:// or https:// URL")
+	ErrInvalidURL = errors.New("beacon: URL contains characters Eddystone-URL cannot carry")
+)
+
+// EddystoneServiceUUID is the 16-bit service UUID all Eddystone frames use.
+var EddystoneServiceUUID = bluetooth.New16BitUUID(eddystoneUUID)
+
+// IBeacon returns the options for an iBeacon advertisement. measuredPower
+// is the RSSI in dBm measured at 1 m from the beacon.
+func IBeacon(proximityUUID bluetooth.UUID, major, minor uint16, measuredPower int8) bluetooth.AdvertisementOptions {
+	data := make([]byte, 0, 23)
+	data = append(data, iBeaconType, iBeaconLength)
+	data = appendUUIDBigEndian(data, proximityUUID)
+	data = binary.BigEndian.AppendUint16(data, major)
+	data = binary.BigEndian.AppendUint16(data, minor)
+	data = append(data, byte(measuredPower))
+
+	return bluetooth.AdvertisementOptions{
+		AdvertisementType: bluetooth.AdvertisingTypeNonConnInd,
+		ManufacturerData: []bluetooth.ManufacturerDataElement{
+			{CompanyID: AppleCompanyID, Data: data},
+		},
+	}
+}
+
+// appendUUIDBigEndian appends the UUID in the byte order it is written in.
+func appendUUIDBigEndian(buf []byte, uuid bluetooth.UUID) []byte {
+	b := uuid.Bytes()
+	for i := len(b) - 1; i >= 0; i-- {
+		buf = append(buf, b[i])
+	}
+	return buf
+}
+
+func eddystone(frame []byte) bluetooth.AdvertisementOptions {
+	return bluetooth.AdvertisementOptions{
+		AdvertisementType: bluetooth.AdvertisingTypeNonConnInd,
+		ServiceUUIDs:      []bluetooth.UUID{EddystoneServiceUUID},
+		ServiceData: []bluetooth.ServiceDataElement{
+			{UUID: EddystoneServiceUUID, Data: frame},
+		},
+	}
+}
+
+// EddystoneUID returns the options for an Eddystone-UID frame. txPower is
+// the calibrated power in dBm at 0 m.
+func EddystoneUID(namespace [10]byte, instance [6]byte, txPower int8) bluetooth.AdvertisementOptions {
+	frame := make([]byte, 0, 20)
+	frame = append(frame, eddystoneUID, byte(txPower))
+	frame = append(frame, namespace[:]...)
+	frame = append(frame, instance[:]...)
+	frame = append(frame, 0, 0) // reserved
+	return eddystone(frame)
+}
+
+// Eddystone-URL scheme prefixes and expansion codes.
+var (
+	urlSchemes = []string{
+		0x00: "http://www.",
+		0x01: "https://www.",
+		0x02: "http://",
+		0x03: "https://",
+	}
+	urlExpansions = []string{
+		0x00: ".com/",
+		0x01: ".org/",
+		0x02: ".edu/",
+		0x03: ".net/",
+		0x04: ".info/",
+		0x05: ".biz/",
+		0x06: ".gov/",
+		0x07: ".com",
+		0x08: ".org",
+		0x09: ".edu",
+		0x0a: ".net",
+		0x0b: ".info",
+		0x0c: ".biz",
+		0x0d: ".gov",
+	}
+)
+
+// EncodeEddystoneURL compresses url with the Eddystone-URL scheme prefixes
+// and expansion codes.
+func EncodeEddystoneURL(url string) ([]byte, error) {
+	var encoded []byte
+	// "http://www." is listed before "http://", so the first match is the
+	// longest one.
+	schemeFound := false
+	for code, scheme := range urlSchemes {
+		if strings.HasPrefix(url, scheme) {
+			encoded = append(encoded, byte(code))
+			url = url[len(scheme):]
+			schemeFound = true
+			break
+		}
+	}
+	if !schemeFound {
+		return nil, ErrURLScheme
+	}
+
+	for len(url) > 0 {
+		matched := false
+		for code, expansion := range urlExpansions {
+			if strings.HasPrefix(url, expansion) {
+				encoded = append(encoded, byte(code))
+				url = url[len(expansion):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			if url[0] <= 0x20 || url[0] >= 0x7f {
+				return nil, ErrInvalidURL
+			}
+			encoded = append(encoded, url[0])
+			url = url[1:]
+		}
+	}
+	if len(encoded)-1 > maxEddystoneURLLength {
+		return nil, ErrURLTooLong
+	}
+	return encoded, nil
+}
+
+// DecodeEddystoneURL is the inverse of EncodeEddystoneURL.
+func DecodeEddystoneURL(encoded []byte) (string, error) {
+	if len(encoded) == 0 || int(encoded[0]) >= len(urlSchemes) {
+		return "", ErrURLScheme
+	}
+	var sb strings.Builder
+	sb.WriteString(urlSchemes[encoded[0]])
+	for _, c := range encoded[1:] {
+		if int(c) < len(urlExpansions) {
+			sb.WriteString(urlExpansions[c])
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String(), nil
+}
+
+// EddystoneURL returns the options for an Eddystone-URL frame.
+func EddystoneURL(url string, txPower int8) (bluetooth.AdvertisementOptions, error) {
+	encoded, err := EncodeEddystoneURL(url)
+	if err != nil {
+		return bluetooth.AdvertisementOptions{}, err
+	}
+	frame := append([]byte{eddystoneURL, byte(txPower)}, encoded...)
+	return eddystone(frame), nil
+}
+
+// TLM is the telemetry carried by an unencrypted Eddystone-TLM frame.
+type TLM struct {
+	// BatteryMillivolts is zero if the beacon is not battery powered.
+	BatteryMillivolts uint16
+	// Temperature in degrees Celsius, sent with 1/256 degree resolution.
+	Temperature float64
+	// AdvertisementCount is the number of frames sent since power up.
+	AdvertisementCount uint32
+	// Uptime since power up, sent with 0.1 s resolution.
+	Uptime time.Duration
+}
+
+func (t TLM) frame() []byte {
+	frame := make([]byte, 0, 14)
+	frame = append(frame, eddystoneTLM, eddystoneTLMv0)
+	frame = binary.BigEndian.AppendUint16(frame, t.BatteryMillivolts)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(int16(t.Temperature*256)))
+	frame = binary.BigEndian.AppendUint32(frame, t.AdvertisementCount)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(t.Uptime/(100*time.Millisecond)))
+	return frame
+}
+
+// EddystoneTLM returns the options for an Eddystone-TLM frame.
+func EddystoneTLM(tlm TLM) bluetooth.AdvertisementOptions {
+	return eddystone(tlm.frame())
+}
+
+// AltBeacon returns the options for an AltBeacon advertisement. refRSSI is
+// the RSSI in dBm measured at 1 m from the beacon.
+func AltBeacon(manufacturerID uint16, beaconID [20]byte, refRSSI int8, reserved byte) bluetooth.AdvertisementOptions {
+	data := make([]byte, 0, 24)
+	data = binary.BigEndian.AppendUint16(data, altBeaconCode)
+	data = append(data, beaconID[:]...)
+	data = append(data, byte(refRSSI), reserved)
+
+	return bluetooth.AdvertisementOptions{
+		AdvertisementType: bluetooth.AdvertisingTypeNonConnInd,
+		ManufacturerData: []bluetooth.ManufacturerDataElement{
+			{CompanyID: manufacturerID, Data: data},
+		},
+	}
+}
+
+// TLMScheduler refreshes the Eddystone-TLM frame of a running advertisement.
+type TLMScheduler struct {
+	// Advertisement must have been configured with EddystoneTLM.
+	Advertisement *bluetooth.Advertisement
+	// Interval between updates. Defaults to 10 s.
+	Interval time.Duration
+	// Read returns the current telemetry. If it leaves Uptime zero, the time
+	// since Run was called is used instead.
+	Read func() TLM
+}
+
+// Run updates the advertisement every Interval until ctx is done.
+func (s *TLMScheduler) Run(ctx context.Context) error {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	start := time.Now()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		tlm := s.Read()
+		if tlm.Uptime == 0 {
+			tlm.Uptime = time.Since(start)
+		}
+		err := s.Advertisement.SetServiceData([]bluetooth.ServiceDataElement{
+			{UUID: EddystoneServiceUUID, Data: tlm.frame()},
+		})
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
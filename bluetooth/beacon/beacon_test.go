@@ -0,0 +1,189 @@
+package beacon
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+func TestEncodeEddystoneURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want []byte
+	}{
+		// The example from the Eddystone-URL specification.
+		{"https://goo.gl/S6zT6P", append([]byte{0x03}, "goo.gl/S6zT6P"...)},
+		{"http://www.example.com/", append(append([]byte{0x00}, "example"...), 0x00)},
+		{"https://www.example.org", append(append([]byte{0x01}, "example"...), 0x08)},
+		{"http://a.com/b.net", []byte{0x02, 'a', 0x00, 'b', 0x0a}},
+		{"https://x.info/y.gov/", []byte{0x03, 'x', 0x04, 'y', 0x06}},
+		// 17 bytes after the scheme, the most a frame can carry.
+		{"https://abcdefghijklmnopq", append([]byte{0x03}, "abcdefghijklmnopq"...)},
+		{"https://abcdefghijklmnop.com/", append(append([]byte{0x03}, "abcdefghijklmnop"...), 0x00)},
+	}
+	for _, tt := range tests {
+		got, err := EncodeEddystoneURL(tt.url)
+		if err != nil {
+			t.Errorf("EncodeEddystoneURL(%q) error = %v", tt.url, err)
+			continue
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("EncodeEddystoneURL(%q) = % x, want % x", tt.url, got, tt.want)
+		}
+		url, err := DecodeEddystoneURL(got)
+		if err != nil || url != tt.url {
+			t.Errorf("DecodeEddystoneURL(% x) = %q, %v, want %q", got, url, err, tt.url)
+		}
+	}
+}
+
+func TestEncodeEddystoneURLErrors(t *testing.T) {
+	tests := []struct {
+		url  string
+		want error
+	}{
+		{"https://abcdefghijklmnopqr", ErrURLTooLong},
+		{"https://abcdefghijklmnopq.com/", ErrURLTooLong},
+		{"ftp://example.com", ErrURLScheme},
+		{"example.com", ErrURLScheme},
+		{"https://a b", ErrInvalidURL},
+		{"https://café", ErrInvalidURL},
+	}
+	for _, tt := range tests {
+		if _, err := EncodeEddystoneURL(tt.url); !errors.Is(err, tt.want) {
+			t.Errorf("EncodeEddystoneURL(%q) error = %v, want %v", tt.url, err, tt.want)
+		}
+	}
+}
+
+func TestDecodeEddystoneURLErrors(t *testing.T) {
+	for _, encoded := range [][]byte{nil, {0x04, 'a'}, {0xff}} {
+		if _, err := DecodeEddystoneURL(encoded); !errors.Is(err, ErrURLScheme) {
+			t.Errorf("DecodeEddystoneURL(% x) error = %v, want %v", encoded, err, ErrURLScheme)
+		}
+	}
+}
+
+func TestFrames(t *testing.T) {
+	// The UUID used in Apple's iBeacon examples.
+	proximityUUID, err := bluetooth.ParseUUID("e2c56db5-dffb-48d2-b060-d0f5a71096e0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var beaconID [20]byte
+	for i := range beaconID {
+		beaconID[i] = byte(i + 1)
+	}
+	url, err := EddystoneURL("https://goo.gl/S6zT6P", -20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opts bluetooth.AdvertisementOptions
+		// company is zero for Eddystone frames, which are service data.
+		company uint16
+		want    []byte
+	}{
+		{
+			name:    "iBeacon",
+			opts:    IBeacon(proximityUUID, 1, 2, -59),
+			company: AppleCompanyID,
+			want: []byte{
+				0x02, 0x15, // type, length
+				0xE2, 0xC5, 0x6D, 0xB5, 0xDF, 0xFB, 0x48, 0xD2, 0xB0, 0x60, 0xD0, 0xF5, 0xA7, 0x10, 0x96, 0xE0,
+				0x00, 0x01, // major
+				0x00, 0x02, // minor
+				0xC5, // measured power
+			},
+		},
+		{
+			name:    "AltBeacon",
+			opts:    AltBeacon(RadiusNetworksCompanyID, beaconID, -59, 0x00),
+			company: RadiusNetworksCompanyID,
+			want: []byte{
+				0xBE, 0xAC, // beacon code
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A,
+				0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11, 0x12, 0x13, 0x14,
+				0xC5, // reference RSSI
+				0x00, // reserved
+			},
+		},
+		{
+			name: "Eddystone-UID",
+			opts: EddystoneUID(
+				[10]byte{0x8B, 0x0C, 0xA7, 0x50, 0xE7, 0xA7, 0x4E, 0x14, 0xBD, 0x99},
+				[6]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+				-18),
+			want: []byte{
+				0x00, 0xEE, // frame type, TX power
+				0x8B, 0x0C, 0xA7, 0x50, 0xE7, 0xA7, 0x4E, 0x14, 0xBD, 0x99,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				0x00, 0x00, // reserved
+			},
+		},
+		{
+			name: "Eddystone-URL",
+			opts: url,
+			want: append([]byte{0x10, 0xEC, 0x03}, "goo.gl/S6zT6P"...),
+		},
+		{
+			name: "Eddystone-TLM",
+			opts: EddystoneTLM(TLM{
+				BatteryMillivolts:  3000,
+				Temperature:        23.5,
+				AdvertisementCount: 256,
+				Uptime:             time.Hour,
+			}),
+			want: []byte{
+				0x20, 0x00, // frame type, version
+				0x0B, 0xB8, // 3000 mV
+				0x17, 0x80, // 23.5 °C in 8.8 fixed point
+				0x00, 0x00, 0x01, 0x00, // advertisement count
+				0x00, 0x00, 0x8C, 0xA0, // 36000 tenths of a second
+			},
+		},
+		{
+			name: "Eddystone-TLM below zero",
+			opts: EddystoneTLM(TLM{Temperature: -1.25}),
+			want: []byte{
+				0x20, 0x00,
+				0x00, 0x00,
+				0xFE, 0xC0, // -1.25 °C
+				0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.opts.AdvertisementType != bluetooth.AdvertisingTypeNonConnInd {
+				t.Errorf("AdvertisementType = %v, want non-connectable", tt.opts.AdvertisementType)
+			}
+			var got []byte
+			if tt.company != 0 {
+				if len(tt.opts.ManufacturerData) != 1 || tt.opts.ManufacturerData[0].CompanyID != tt.company {
+					t.Fatalf("ManufacturerData = %+v, want one element for company %#04x", tt.opts.ManufacturerData, tt.company)
+				}
+				got = tt.opts.ManufacturerData[0].Data
+			} else {
+				want := []bluetooth.UUID{EddystoneServiceUUID}
+				if !reflect.DeepEqual(tt.opts.ServiceUUIDs, want) {
+					t.Errorf("ServiceUUIDs = %v, want %v", tt.opts.ServiceUUIDs, want)
+				}
+				if len(tt.opts.ServiceData) != 1 || tt.opts.ServiceData[0].UUID != EddystoneServiceUUID {
+					t.Fatalf("ServiceData = %+v, want one Eddystone element", tt.opts.ServiceData)
+				}
+				got = tt.opts.ServiceData[0].Data
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("data = % x\nwant   % x", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package beacon
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+// Kind is the beacon format a frame was recognized as.
+type Kind int
+
+const (
+	KindIBeacon Kind = iota + 1
+	KindEddystoneUID
+	KindEddystoneURL
+	KindEddystoneTLM
+	KindEddystoneEID
+	KindAltBeacon
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindIBeacon:
+		return "iBeacon"
+	case KindEddystoneUID:
+		return "Eddystone-UID"
+	case KindEddystoneURL:
+		return "Eddystone-URL"
+	case KindEddystoneTLM:
+		return "Eddystone-TLM"
+	case KindEddystoneEID:
+		return "Eddystone-EID"
+	case KindAltBeacon:
+		return "AltBeacon"
+	}
+	return "Kind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// eddystoneTxPowerOffset converts the Eddystone power at 0 m to the power at
+// 1 m that iBeacon and AltBeacon advertise, as recommended by the Eddystone
+// specification.
+const eddystoneTxPowerOffset = 41
+
+// eddystoneMeasuredPower converts the TX power byte of an Eddystone frame to
+// the power at 1 m, clamped to the int8 range.
+func eddystoneMeasuredPower(txPower byte) int8 {
+	return int8(max(int(int8(txPower))-eddystoneTxPowerOffset, math.MinInt8))
+}
+
+// Beacon is a decoded beacon frame. Only the fields of its Kind are set.
+type Beacon struct {
+	Kind Kind
+	// MeasuredPower is the expected RSSI in dBm at 1 m. It is not set for
+	// Eddystone-TLM frames, which carry no power level.
+	MeasuredPower int8
+
+	// iBeacon.
+	ProximityUUID bluetooth.UUID
+	Major, Minor  uint16
+
+	// Eddystone-UID.
+	Namespace [10]byte
+	Instance  [6]byte
+
+	// Eddystone-URL.
+	URL string
+
+	// Eddystone-TLM.
+	TLM TLM
+
+	// Eddystone-EID.
+	EphemeralID [8]byte
+
+	// AltBeacon.
+	ManufacturerID uint16
+	BeaconID       [20]byte
+	Reserved       byte
+}
+
+// ID returns a string that identifies the beacon across frames, suitable as
+// a map key. Eddystone-TLM frames have no identity of their own and return
+// an empty string.
+func (b Beacon) ID() string {
+	switch b.Kind {
+	case KindIBeacon:
+		return "ibeacon:" + b.ProximityUUID.String() + ":" + strconv.Itoa(int(b.Major)) + ":" + strconv.Itoa(int(b.Minor))
+	case KindEddystoneUID:
+		return "eddystone-uid:" + hex.EncodeToString(b.Namespace[:]) + ":" + hex.EncodeToString(b.Instance[:])
+	case KindEddystoneURL:
+		return "eddystone-url:" + b.URL
+	case KindEddystoneEID:
+		return "eddystone-eid:" + hex.EncodeToString(b.EphemeralID[:])
+	case KindAltBeacon:
+		return "altbeacon:" + strconv.Itoa(int(b.ManufacturerID)) + ":" + hex.EncodeToString(b.BeaconID[:])
+	}
+	return ""
+}
+
+// Parse recognizes a beacon in the manufacturer and service data of an
+// advertisement.
+func Parse(manufacturerData []bluetooth.ManufacturerDataElement, serviceData []bluetooth.ServiceDataElement) (Beacon, bool) {
+	for _, element := range manufacturerData {
+		if b, ok := parseManufacturerData(element); ok {
+			return b, true
+		}
+	}
+	for _, element := range serviceData {
+		if element.UUID == EddystoneServiceUUID {
+			if b, ok := parseEddystone(element.Data); ok {
+				return b, true
+			}
+		}
+	}
+	return Beacon{}, false
+}
+
+// ParseScanResult is like Parse for a scan result.
+func ParseScanResult(result bluetooth.ScanResult) (Beacon, bool) {
+	return Parse(result.ManufacturerData, result.ServiceData)
+}
+
+func parseManufacturerData(element bluetooth.ManufacturerDataElement) (Beacon, bool) {
+	data := element.Data
+	switch {
+	case element.CompanyID == AppleCompanyID && len(data) == 23 && data[0] == iBeaconType && data[1] == iBeaconLength:
+		var uuid [16]byte
+		copy(uuid[:], data[2:18])
+		return Beacon{
+			Kind:          KindIBeacon,
+			ProximityUUID: bluetooth.NewUUID(uuid),
+			Major:         binary.BigEndian.Uint16(data[18:]),
+			Minor:         binary.BigEndian.Uint16(data[20:]),
+			MeasuredPower: int8(data[22]),
+		}, true
+	case len(data) == 24 && binary.BigEndian.Uint16(data) == altBeaconCode:
+		b := Beacon{
+			Kind:           KindAltBeacon,
+			ManufacturerID: element.CompanyID,
+			MeasuredPower:  int8(data[22]),
+			Reserved:       data[23],
+		}
+		copy(b.BeaconID[:], data[2:22])
+		return b, true
+	}
+	return Beacon{}, false
+}
+
+func parseEddystone(frame []byte) (Beacon, bool) {
+	if len(frame) < 2 {
+		return Beacon{}, false
+	}
+	switch frame[0] {
+	case eddystoneUID:
+		if len(frame) < 18 {
+			return Beacon{}, false
+		}
+		b := Beacon{Kind: KindEddystoneUID, MeasuredPower: eddystoneMeasuredPower(frame[1])}
+		copy(b.Namespace[:], frame[2:12])
+		copy(b.Instance[:], frame[12:18])
+		return b, true
+	case eddystoneURL:
+		url, err := DecodeEddystoneURL(frame[2:])
+		if err != nil {
+			return Beacon{}, false
+		}
+		return Beacon{Kind: KindEddystoneURL, MeasuredPower: eddystoneMeasuredPower(frame[1]), URL: url}, true
+	case eddystoneTLM:
+		// Only the unencrypted version 0 frame can be decoded.
+		if len(frame) != 14 || frame[1] != eddystoneTLMv0 {
+			return Beacon{}, false
+		}
+		return Beacon{Kind: KindEddystoneTLM, TLM: TLM{
+			BatteryMillivolts:  binary.BigEndian.Uint16(frame[2:]),
+			Temperature:        float64(int16(binary.BigEndian.Uint16(frame[4:]))) / 256,
+			AdvertisementCount: binary.BigEndian.Uint32(frame[6:]),
+			Uptime:             time.Duration(binary.BigEndian.Uint32(frame[10:])) * 100 * time.Millisecond,
+		}}, true
+	case eddystoneEID:
+		if len(frame) < 10 {
+			return Beacon{}, false
+		}
+		b := Beacon{Kind: KindEddystoneEID, MeasuredPower: eddystoneMeasuredPower(frame[1])}
+		copy(b.EphemeralID[:], frame[2:10])
+		return b, true
+	}
+	return Beacon{}, false
+}
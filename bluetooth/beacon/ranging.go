@@ -0,0 +1,279 @@
+package beacon
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+// Filter smooths a stream of RSSI samples.
+type Filter interface {
+	// Update adds a sample and returns the smoothed value.
+	Update(rssi float64) float64
+}
+
+// MovingAverage averages the last Window samples.
+type MovingAverage struct {
+	Window  int
+	samples []float64
+}
+
+func (f *MovingAverage) Update(rssi float64) float64 {
+	window := f.Window
+	if window <= 0 {
+		window = 10
+	}
+	f.samples = append(f.samples, rssi)
+	if len(f.samples) > window {
+		f.samples = f.samples[len(f.samples)-window:]
+	}
+	var sum float64
+	for _, s := range f.samples {
+		sum += s
+	}
+	return sum / float64(len(f.samples))
+}
+
+// Kalman is a one-dimensional Kalman filter for a value that is expected to
+// stay roughly constant, such as the RSSI of a beacon that does not move.
+type Kalman struct {
+	// ProcessNoise is how much the true RSSI is expected to drift between
+	// samples; MeasurementNoise is the variance of a single sample. Zero
+	// values default to 0.05 and 4.
+	ProcessNoise     float64
+	MeasurementNoise float64
+
+	estimate    float64
+	errorCov    float64
+	initialized bool
+}
+
+func (f *Kalman) Update(rssi float64) float64 {
+	processNoise := f.ProcessNoise
+	if processNoise <= 0 {
+		processNoise = 0.05
+	}
+	measurementNoise := f.MeasurementNoise
+	if measurementNoise <= 0 {
+		measurementNoise = 4
+	}
+
+	if !f.initialized {
+		f.estimate = rssi
+		f.errorCov = measurementNoise
+		f.initialized = true
+		return f.estimate
+	}
+	f.errorCov += processNoise
+	gain := f.errorCov / (f.errorCov + measurementNoise)
+	f.estimate += gain * (rssi - f.estimate)
+	f.errorCov *= 1 - gain
+	return f.estimate
+}
+
+// EstimateDistance returns the distance in meters at which a beacon with
+// the given measured power (RSSI at 1 m) is received with rssi, using the
+// log-distance path loss model. pathLoss is 2 in free space and typically
+// 2 to 4 indoors.
+func EstimateDistance(rssi float64, measuredPower int8, pathLoss float64) float64 {
+	if pathLoss <= 0 {
+		pathLoss = 2
+	}
+	return math.Pow(10, (float64(measuredPower)-rssi)/(10*pathLoss))
+}
+
+// EventType is the kind of ranging event.
+type EventType int
+
+const (
+	// EventEnter is sent when a beacon comes within range.
+	EventEnter EventType = iota + 1
+	// EventNear is sent when a beacon comes within NearDistance.
+	EventNear
+	// EventFar is sent when a near beacon moves away again.
+	EventFar
+	// EventExit is sent when a beacon leaves range or is no longer seen.
+	EventExit
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventEnter:
+		return "enter"
+	case EventNear:
+		return "near"
+	case EventFar:
+		return "far"
+	case EventExit:
+		return "exit"
+	}
+	return "unknown"
+}
+
+// Event reports a change in the presence of a beacon.
+type Event struct {
+	Type    EventType
+	Beacon  Beacon
+	Address bluetooth.Address
+	// RSSI is the filtered RSSI and Distance the estimate derived from it.
+	RSSI     float64
+	Distance float64
+}
+
+// RangerConfig tunes a Ranger. Zero fields take the defaults listed.
+type RangerConfig struct {
+	// NewFilter creates the RSSI filter for each beacon. Defaults to a
+	// Kalman filter.
+	NewFilter func() Filter
+	// PathLoss is the path loss exponent. Defaults to 2.
+	PathLoss float64
+	// RangeDistance is the distance within which a beacon is in range.
+	// Defaults to 10 m.
+	RangeDistance float64
+	// NearDistance is the distance within which a beacon is near. Defaults
+	// to 1 m.
+	NearDistance float64
+	// Hysteresis is added to a distance threshold before the state is left
+	// again, so that a beacon on the boundary does not flap. Defaults to
+	// 0.5 m.
+	Hysteresis float64
+	// ExitTimeout is how long a beacon may go unseen before it exits.
+	// Defaults to 10 s.
+	ExitTimeout time.Duration
+}
+
+type beaconState struct {
+	beacon   Beacon
+	address  bluetooth.Address
+	filter   Filter
+	rssi     float64
+	distance float64
+	lastSeen time.Time
+	inRange  bool
+	near     bool
+}
+
+// Ranger tracks beacons seen in scan results and reports enter, near, far
+// and exit events.
+type Ranger struct {
+	config  RangerConfig
+	onEvent func(event Event)
+
+	mu      sync.Mutex
+	beacons map[string]*beaconState
+}
+
+// NewRanger returns a ranger that calls onEvent for every event. onEvent is
+// called from the goroutine that feeds the ranger.
+func NewRanger(config RangerConfig, onEvent func(event Event)) *Ranger {
+	if config.NewFilter == nil {
+		config.NewFilter = func() Filter {
+			return &Kalman{}
+		}
+	}
+	if config.PathLoss <= 0 {
+		config.PathLoss = 2
+	}
+	if config.RangeDistance <= 0 {
+		config.RangeDistance = 10
+	}
+	if config.NearDistance <= 0 {
+		config.NearDistance = 1
+	}
+	if config.Hysteresis <= 0 {
+		config.Hysteresis = 0.5
+	}
+	if config.ExitTimeout <= 0 {
+		config.ExitTimeout = 10 * time.Second
+	}
+	return &Ranger{
+		config:  config,
+		onEvent: onEvent,
+		beacons: make(map[string]*beaconState),
+	}
+}
+
+// Observe feeds a scan result to the ranger. Results that are not beacons,
+// and Eddystone-TLM frames, are ignored.
+func (r *Ranger) Observe(result bluetooth.ScanResult) {
+	b, ok := ParseScanResult(result)
+	if !ok || b.ID() == "" {
+		return
+	}
+	r.observe(b, result.Address, float64(result.RSSI), time.Now())
+}
+
+func (r *Ranger) observe(b Beacon, address bluetooth.Address, rssi float64, now time.Time) {
+	r.mu.Lock()
+	state, ok := r.beacons[b.ID()]
+	if !ok {
+		state = &beaconState{filter: r.config.NewFilter()}
+		r.beacons[b.ID()] = state
+	}
+	state.beacon = b
+	state.address = address
+	state.lastSeen = now
+	state.rssi = state.filter.Update(rssi)
+	state.distance = EstimateDistance(state.rssi, b.MeasuredPower, r.config.PathLoss)
+
+	var events []Event
+	emit := func(t EventType) {
+		events = append(events, Event{Type: t, Beacon: b, Address: address, RSSI: state.rssi, Distance: state.distance})
+	}
+	switch {
+	case !state.inRange && state.distance <= r.config.RangeDistance:
+		state.inRange = true
+		emit(EventEnter)
+	case state.inRange && state.distance > r.config.RangeDistance+r.config.Hysteresis:
+		if state.near {
+			state.near = false
+			emit(EventFar)
+		}
+		state.inRange = false
+		emit(EventExit)
+	}
+	if state.inRange {
+		switch {
+		case !state.near && state.distance <= r.config.NearDistance:
+			state.near = true
+			emit(EventNear)
+		case state.near && state.distance > r.config.NearDistance+r.config.Hysteresis:
+			state.near = false
+			emit(EventFar)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, event := range events {
+		r.onEvent(event)
+	}
+}
+
+// Expire sends far and exit events for beacons not seen for ExitTimeout and
+// forgets them. It should be called periodically; Run does so.
+func (r *Ranger) Expire(now time.Time) {
+	var events []Event
+	r.mu.Lock()
+	for id, state := range r.beacons {
+		if now.Sub(state.lastSeen) < r.config.ExitTimeout {
+			continue
+		}
+		emit := func(t EventType) {
+			events = append(events, Event{Type: t, Beacon: state.beacon, Address: state.address, RSSI: state.rssi, Distance: state.distance})
+		}
+		if state.near {
+			emit(EventFar)
+		}
+		if state.inRange {
+			emit(EventExit)
+		}
+		delete(r.beacons, id)
+	}
+	r.mu.Unlock()
+
+	for _, event := range events {
+		r.onEvent(event)
+	}
+}
@@ -0,0 +1,26 @@
+package beacon
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+// Run scans with adapter and feeds every result to the ranger until ctx is
+// done. Scanning can run while the same adapter is advertising.
+func (r *Ranger) Run(ctx context.Context, adapter *bluetooth.Adapter) error {
+	go func() {
+		ticker := time.NewTicker(r.config.ExitTimeout / 4)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				r.Expire(now)
+			}
+		}
+	}()
+	return adapter.Scan(ctx, r.Observe)
+}
@@ -0,0 +1,61 @@
+package beacon
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+func TestEddystoneMeasuredPower(t *testing.T) {
+	tests := []struct {
+		txPower byte
+		want    int8
+	}{
+		{0x00, -41},
+		{0xEE, -59},
+		{0x7F, 86},
+		{0xA9, -128}, // -87 dBm
+		{0x9C, -128}, // -100 dBm
+		{0x80, -128},
+	}
+	for _, tt := range tests {
+		frame := append([]byte{eddystoneUID, tt.txPower}, make([]byte, 16)...)
+		b, ok := parseEddystone(frame)
+		if !ok {
+			t.Fatalf("parseEddystone(% x) failed", frame)
+		}
+		if b.MeasuredPower != tt.want {
+			t.Errorf("TX power %d: MeasuredPower = %d, want %d", int8(tt.txPower), b.MeasuredPower, tt.want)
+		}
+	}
+}
+
+func TestKalmanZeroValue(t *testing.T) {
+	var f Kalman
+	for _, rssi := range []float64{-60, -62, -58, -61} {
+		if got := f.Update(rssi); math.IsNaN(got) {
+			t.Fatalf("Update(%v) = NaN", rssi)
+		}
+	}
+}
+
+func TestRangerExpireNear(t *testing.T) {
+	var events []EventType
+	r := NewRanger(RangerConfig{NewFilter: func() Filter { return &MovingAverage{Window: 1} }}, func(event Event) {
+		events = append(events, event.Type)
+	})
+
+	start := time.Now()
+	b := Beacon{Kind: KindIBeacon, MeasuredPower: -59}
+	r.observe(b, bluetooth.Address{}, -59, start)
+	r.Expire(start.Add(time.Second))
+	r.Expire(start.Add(time.Minute))
+
+	want := []EventType{EventEnter, EventNear, EventFar, EventExit}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("events = %v, want %v", events, want)
+	}
+}
@@ -0,0 +1,70 @@
+package bluetooth
+
+import (
+	"github.com/godbus/dbus/v5"
+)
+
+// Connection returns the ID that GATT read and write events from this
+// device carry. IDs are assigned on first use and stay the same for as long
+// as BlueZ keeps the device object, so they survive reconnects.
+func (d Device) Connection() Connection {
+	return d.adapter.connection(d.device.Path())
+}
+
+// ConnectionDevice returns the device of a connection ID passed to a GATT
+// event.
+func (a *Adapter) ConnectionDevice(conn Connection) (Device, bool) {
+	a.mu.Lock()
+	var path dbus.ObjectPath
+	for p, c := range a.connections {
+		if c == conn {
+			path = p
+			break
+		}
+	}
+	a.mu.Unlock()
+	if path == "" {
+		return Device{}, false
+	}
+
+	device := Device{
+		device:  a.bus.Object("org.bluez", path),
+		adapter: a,
+	}
+	var props map[string]dbus.Variant
+	if err := device.device.Call("org.freedesktop.DBus.Properties.GetAll", 0, bluezDevice1Interface).Store(&props); err != nil {
+		return Device{}, false
+	}
+	if err := device.parseProperties(&props); err != nil {
+		return Device{}, false
+	}
+	return device, true
+}
+
+func (a *Adapter) connection(path dbus.ObjectPath) Connection {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if conn, ok := a.connections[path]; ok {
+		return conn
+	}
+	if a.connections == nil {
+		a.connections = make(map[dbus.ObjectPath]Connection)
+	}
+	// 0 is kept for events without a known client.
+	a.lastConnection++
+	if a.lastConnection == 0 {
+		a.lastConnection++
+	}
+	a.connections[path] = a.lastConnection
+	return a.lastConnection
+}
+
+// clientConnection returns the connection of the device BlueZ passes in the
+// options of a GATT read or write, or 0 if it does not.
+func (a *Adapter) clientConnection(options map[string]dbus.Variant) Connection {
+	path, ok := options["device"].Value().(dbus.ObjectPath)
+	if !ok {
+		return 0
+	}
+	return a.connection(path)
+}
@@ -0,0 +1,35 @@
+package bluetooth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// getProperty reads a single D-Bus property, honouring ctx.
+func getProperty(ctx context.Context, obj dbus.BusObject, iface, name string) (dbus.Variant, error) {
+	var v dbus.Variant
+	err := obj.CallWithContext(ctx, "org.freedesktop.DBus.Properties.Get", 0, iface, name).Store(&v)
+	return v, wrapError(err)
+}
+
+// setProperty writes a single D-Bus property, honouring ctx.
+func setProperty(ctx context.Context, obj dbus.BusObject, iface, name string, value interface{}) error {
+	err := obj.CallWithContext(ctx, "org.freedesktop.DBus.Properties.Set", 0, iface, name, dbus.MakeVariant(value)).Err
+	return wrapError(err)
+}
+
+// wrapError converts org.bluez.Error.* replies into a *BlueZError. Any other
+// error is returned unchanged.
+func wrapError(err error) error {
+	dbusErr, ok := err.(dbus.Error)
+	if !ok || !strings.HasPrefix(dbusErr.Name, bluezErrorPrefix) {
+		return err
+	}
+	var message string
+	if len(dbusErr.Body) > 0 {
+		message, _ = dbusErr.Body[0].(string)
+	}
+	return NewBlueZError(dbusErr.Name, message)
+}
@@ -0,0 +1,87 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// PairedDevices returns all devices that are paired with this adapter.
+func (a *Adapter) PairedDevices() ([]Device, error) {
+	var objects map[dbus.ObjectPath]map[string]map[string]dbus.Variant
+	err := a.bluez.Call("org.freedesktop.DBus.ObjectManager.GetManagedObjects", 0).Store(&objects)
+	if err != nil {
+		return nil, fmt.Errorf("bluetooth: could not list devices: %w", wrapError(err))
+	}
+
+	var devices []Device
+	for path, interfaces := range objects {
+		props, ok := interfaces[bluezDevice1Interface]
+		if !ok {
+			continue
+		}
+		if adapterPath, _ := props[bluezDevice1Adapter].Value().(dbus.ObjectPath); adapterPath != a.adapter.Path() {
+			continue
+		}
+		if paired, _ := props[bluezDevice1Paired].Value().(bool); !paired {
+			continue
+		}
+
+		device := Device{
+			device:  a.bus.Object("org.bluez", path),
+			adapter: a,
+		}
+		if err := device.parseProperties(&props); err != nil {
+			continue
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
+
+// RemoveDevice removes the device and its bonding information. The device
+// has to pair again before it can use encrypted characteristics.
+func (a *Adapter) RemoveDevice(device Device) error {
+	err := a.adapter.Call("org.bluez.Adapter1.RemoveDevice", 0, device.device.Path()).Err
+	if err != nil {
+		return fmt.Errorf("bluetooth: could not remove device: %w", wrapError(err))
+	}
+	return nil
+}
+
+// Pair starts pairing with the device and waits until it is done. The
+// registered Agent is asked for passkeys or confirmation as needed.
+func (d Device) Pair(ctx context.Context) error {
+	err := d.device.CallWithContext(ctx, "org.bluez.Device1.Pair", 0).Err
+	if err != nil {
+		if ctx.Err() != nil {
+			d.CancelPairing()
+		}
+		return fmt.Errorf("bluetooth: could not pair: %w", wrapError(err))
+	}
+	return nil
+}
+
+// CancelPairing aborts a pairing started with Pair.
+func (d Device) CancelPairing() error {
+	return wrapError(d.device.Call("org.bluez.Device1.CancelPairing", 0).Err)
+}
+
+// SetTrusted marks the device as trusted, so that it can connect without
+// the agent being asked for authorization.
+func (d Device) SetTrusted(trusted bool) error {
+	if err := setProperty(context.Background(), d.device, bluezDevice1Interface, bluezDevice1Trusted, trusted); err != nil {
+		return fmt.Errorf("bluetooth: could not set trusted: %w", err)
+	}
+	return nil
+}
+
+// SetBlocked blocks or unblocks the device. Blocked devices are
+// disconnected and all their connections are refused.
+func (d Device) SetBlocked(blocked bool) error {
+	if err := setProperty(context.Background(), d.device, bluezDevice1Interface, bluezDevice1Blocked, blocked); err != nil {
+		return fmt.Errorf("bluetooth: could not set blocked: %w", err)
+	}
+	return nil
+}
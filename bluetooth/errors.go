@@ -0,0 +1,115 @@
+package bluetooth
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrAdvertisementNotStarted     = errors.New("bluetooth: advertisement is not started")
+	ErrAdvertisementAlreadyStarted = errors.New("bluetooth: advertisement is already started")
+	ErrAdapterNotPowered           = errors.New("bluetooth: adapter is not powered")
+	ErrAdapterNotFound             = errors.New("bluetooth: adapter does not exist")
+)
+
+// Sentinel errors for the org.bluez.Error.* names. A *BlueZError unwraps to
+// one of these, so callers can use errors.Is(err, ErrNotReady) and so on.
+var (
+	ErrNotReady                = errors.New("bluetooth: not ready")
+	ErrInProgress              = errors.New("bluetooth: operation in progress")
+	ErrNotPermitted            = errors.New("bluetooth: not permitted")
+	ErrNotAuthorized           = errors.New("bluetooth: not authorized")
+	ErrFailed                  = errors.New("bluetooth: operation failed")
+	ErrAlreadyExists           = errors.New("bluetooth: already exists")
+	ErrDoesNotExist            = errors.New("bluetooth: does not exist")
+	ErrNotSupported            = errors.New("bluetooth: not supported")
+	ErrNotAvailable            = errors.New("bluetooth: not available")
+	ErrInvalidArguments        = errors.New("bluetooth: invalid arguments")
+	ErrInvalidLength           = errors.New("bluetooth: invalid length")
+	ErrInvalidOffset           = errors.New("bluetooth: invalid offset")
+	ErrAlreadyConnected        = errors.New("bluetooth: already connected")
+	ErrNotConnected            = errors.New("bluetooth: not connected")
+	ErrConnectionAttemptFailed = errors.New("bluetooth: connection attempt failed")
+	ErrAuthenticationFailed    = errors.New("bluetooth: authentication failed")
+	ErrAuthenticationCanceled  = errors.New("bluetooth: authentication canceled")
+	ErrAuthenticationRejected  = errors.New("bluetooth: authentication rejected")
+	ErrAuthenticationTimeout   = errors.New("bluetooth: authentication timeout")
+	ErrRejected                = errors.New("bluetooth: rejected")
+	ErrCanceled                = errors.New("bluetooth: canceled")
+	ErrUnknownBlueZError       = errors.New("bluetooth: unknown BlueZ error")
+)
+
+const bluezErrorPrefix = "org.bluez.Error."
+
+var bluezErrors = map[string]error{
+	"NotReady":                ErrNotReady,
+	"InProgress":              ErrInProgress,
+	"NotPermitted":            ErrNotPermitted,
+	"NotAuthorized":           ErrNotAuthorized,
+	"Failed":                  ErrFailed,
+	"AlreadyExists":           ErrAlreadyExists,
+	"DoesNotExist":            ErrDoesNotExist,
+	"NotSupported":            ErrNotSupported,
+	"NotAvailable":            ErrNotAvailable,
+	"InvalidArguments":        ErrInvalidArguments,
+	"InvalidValueLength":      ErrInvalidLength,
+	"InvalidLength":           ErrInvalidLength,
+	"InvalidOffset":           ErrInvalidOffset,
+	"AlreadyConnected":        ErrAlreadyConnected,
+	"NotConnected":            ErrNotConnected,
+	"ConnectionAttemptFailed": ErrConnectionAttemptFailed,
+	"AuthenticationFailed":    ErrAuthenticationFailed,
+	"AuthenticationCanceled":  ErrAuthenticationCanceled,
+	"AuthenticationRejected":  ErrAuthenticationRejected,
+	"AuthenticationTimeout":   ErrAuthenticationTimeout,
+	"Rejected":                ErrRejected,
+	"Canceled":                ErrCanceled,
+}
+
+// BlueZError is an error returned by bluetoothd, identified by its
+// org.bluez.Error.* name.
+type BlueZError struct {
+	// Name is the full D-Bus error name, e.g. "org.bluez.Error.NotReady".
+	Name string
+	// Message is the human readable text sent by BlueZ, if any.
+	Message string
+
+	err error
+}
+
+// NewBlueZError builds a BlueZError for the given D-Bus error name.
+func NewBlueZError(name, message string) *BlueZError {
+	err, ok := bluezErrors[strings.TrimPrefix(name, bluezErrorPrefix)]
+	if !ok {
+		err = ErrUnknownBlueZError
+	}
+	return &BlueZError{Name: name, Message: message, err: err}
+}
+
+func (e *BlueZError) Error() string {
+	if e.Message == "" {
+		return "bluetooth: " + e.Name
+	}
+	return "bluetooth: " + e.Name + ": " + e.Message
+}
+
+// Unwrap returns the sentinel error matching the BlueZ error name.
+func (e *BlueZError) Unwrap() error {
+	return e.err
+}
+
+// Temporary reports whether the operation may succeed if retried later.
+func (e *BlueZError) Temporary() bool {
+	switch e.err {
+	case ErrNotReady, ErrInProgress, ErrFailed, ErrConnectionAttemptFailed, ErrAuthenticationTimeout:
+		return true
+	}
+	return false
+}
+
+// IsTemporary reports whether err, or any error it wraps, is a BlueZ error
+// that may go away when the operation is retried.
+func IsTemporary(err error) bool {
+	var bzErr *BlueZError
+	return errors.As(err, &bzErr) && bzErr.Temporary()
+}
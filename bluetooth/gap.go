@@ -5,6 +5,44 @@ type MACAddress struct {
 	isRandom bool
 }
 
+// IsRandom reports whether this is a random rather than a public address.
+func (mac MACAddress) IsRandom() bool {
+	return mac.isRandom
+}
+
+// SetRandom marks the address as random or public.
+func (mac *MACAddress) SetRandom(isRandom bool) {
+	mac.isRandom = isRandom
+}
+
+// IsPublic reports whether this is an IEEE assigned public address.
+func (mac MACAddress) IsPublic() bool {
+	return !mac.isRandom
+}
+
+// The two most significant bits of a random address give its sub-type.
+func (mac MACAddress) randomSubType() byte {
+	return mac.MAC[5] >> 6
+}
+
+// IsRandomStatic reports whether this is a static random address, which
+// stays the same until the device is power cycled or reset.
+func (mac MACAddress) IsRandomStatic() bool {
+	return mac.isRandom && mac.randomSubType() == 0b11
+}
+
+// IsResolvablePrivate reports whether this is a resolvable private address,
+// which can be mapped to its owner with the owner's IRK.
+func (mac MACAddress) IsResolvablePrivate() bool {
+	return mac.isRandom && mac.randomSubType() == 0b01
+}
+
+// IsNonResolvablePrivate reports whether this is a non-resolvable private
+// address, which cannot be traced back to its owner.
+func (mac MACAddress) IsNonResolvablePrivate() bool {
+	return mac.isRandom && mac.randomSubType() == 0b00
+}
+
 type Connection uint16
 
 type AdvertisingType int
@@ -44,3 +82,17 @@ type ServiceDataElement struct {
 	UUID UUID
 	Data []byte
 }
+
+// ScanResult is an advertisement seen while scanning.
+type ScanResult struct {
+	Address Address
+	// RSSI in dBm of the last received advertisement.
+	RSSI int16
+
+	LocalName        string
+	ServiceUUIDs     []UUID
+	ManufacturerData []ManufacturerDataElement
+	ServiceData      []ServiceDataElement
+	// TxPower in dBm, zero if the advertisement did not include it.
+	TxPower int16
+}
@@ -1,6 +1,7 @@
 package bluetooth
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync/atomic"
@@ -23,15 +24,23 @@ const (
 	dbusSignalInterfacesAdded   = "org.freedesktop.DBus.ObjectManager.InterfacesAdded"
 	dbusSignalPropertiesChanged = "org.freedesktop.DBus.Properties.PropertiesChanged"
 
-	bluezDevice1Interface = "org.bluez.Device1"
-	bluezDevice1Address   = "Address"
-	bluezDevice1Connected = "Connected"
+	bluezDevice1Interface   = "org.bluez.Device1"
+	bluezDevice1Address     = "Address"
+	bluezDevice1AddressType = "AddressType"
+	bluezDevice1Name        = "Name"
+	bluezDevice1Alias       = "Alias"
+	bluezDevice1Connected   = "Connected"
+	bluezDevice1Paired      = "Paired"
+	bluezDevice1Bonded      = "Bonded"
+	bluezDevice1Trusted     = "Trusted"
+	bluezDevice1Blocked     = "Blocked"
+	bluezDevice1RSSI        = "RSSI"
+	bluezDevice1TxPower     = "TxPower"
+	bluezDevice1Appearance  = "Appearance"
+	bluezDevice1UUIDs       = "UUIDs"
+	bluezDevice1Adapter     = "Adapter"
 )
 
-var errAdvertisementNotStarted = errors.New("bluetooth: advertisement is not started")
-var errAdvertisementAlreadyStarted = errors.New("bluetooth: advertisement is already started")
-var errAdaptorNotPowered = errors.New("bluetooth: adaptor is not powered")
-
 var advertisementID uint64
 
 var (
@@ -43,6 +52,8 @@ var (
 	// See [DBusObjectManagerLink] for more information.
 	matchOptionsInterfacesAdded = []dbus.MatchOption{dbus.WithMatchInterface("org.freedesktop.DBus.ObjectManager"),
 		dbus.WithMatchMember("InterfacesAdded")}
+	matchOptionsInterfacesRemoved = []dbus.MatchOption{dbus.WithMatchInterface("org.freedesktop.DBus.ObjectManager"),
+		dbus.WithMatchMember("InterfacesRemoved")}
 )
 
 type Address struct {
@@ -52,6 +63,21 @@ type Address struct {
 type Device struct {
 	Address Address
 
+	// AddressType is "public" or "random".
+	AddressType string
+	Name        string
+	Alias       string
+	Connected   bool
+	Paired      bool
+	Bonded      bool
+	Trusted     bool
+	Blocked     bool
+	// RSSI and TxPower are in dBm and zero when unknown.
+	RSSI       int16
+	TxPower    int16
+	Appearance uint16
+	UUIDs      []UUID
+
 	device  dbus.BusObject
 	adapter *Adapter
 }
@@ -77,7 +103,7 @@ func (a *Adapter) DefaultAdvertisement() *Advertisement {
 
 func (a *Advertisement) Configure(options AdvertisementOptions) error {
 	if a.started {
-		return errAdvertisementAlreadyStarted
+		return ErrAdvertisementAlreadyStarted
 	}
 
 	var serviceUUIDs []string
@@ -100,9 +126,9 @@ func (a *Advertisement) Configure(options AdvertisementOptions) error {
 		"org.bluez.LEAdvertisement1": {
 			"Type":             {Value: "broadcast"},
 			"ServiceUUIDs":     {Value: serviceUUIDs},
-			"ManufacturerData": {Value: manufacturerData},
+			"ManufacturerData": {Value: manufacturerData, Writable: true, Emit: prop.EmitTrue},
 			"LocalName":        {Value: options.LocalName},
-			"ServiceData":      {Value: serviceData, Writable: true},
+			"ServiceData":      {Value: serviceData, Writable: true, Emit: prop.EmitTrue},
 			"Timeout":          {Value: uint16(0)},
 		},
 	}
@@ -116,13 +142,46 @@ func (a *Advertisement) Configure(options AdvertisementOptions) error {
 	if options.LocalName != "" {
 		call := a.adapter.adapter.Call("org.freedesktop.DBus.Properties.Set", 0, "org.bluez.Adapter1", "Alias", dbus.MakeVariant((options.LocalName)))
 		if call.Err != nil {
-			return fmt.Errorf("set adapter alias: %w", call.Err)
+			return fmt.Errorf("set adapter alias: %w", wrapError(call.Err))
 		}
 	}
 
 	return nil
 }
 
+// SetServiceData replaces the service data of a configured advertisement.
+// The change is announced with PropertiesChanged, so BlueZ updates a running
+// advertisement without registering it again.
+func (a *Advertisement) SetServiceData(elements []ServiceDataElement) error {
+	if a.properties == nil {
+		return ErrAdvertisementNotStarted
+	}
+	serviceData := make(map[string]interface{})
+	for _, element := range elements {
+		serviceData[element.UUID.String()] = element.Data
+	}
+	if err := a.properties.Set("org.bluez.LEAdvertisement1", "ServiceData", dbus.MakeVariant(serviceData)); err != nil {
+		return fmt.Errorf("bluetooth: could not set service data: %w", err)
+	}
+	return nil
+}
+
+// SetManufacturerData replaces the manufacturer data of a configured
+// advertisement, in the same way as SetServiceData.
+func (a *Advertisement) SetManufacturerData(elements []ManufacturerDataElement) error {
+	if a.properties == nil {
+		return ErrAdvertisementNotStarted
+	}
+	manufacturerData := map[uint16]any{}
+	for _, element := range elements {
+		manufacturerData[element.CompanyID] = element.Data
+	}
+	if err := a.properties.Set("org.bluez.LEAdvertisement1", "ManufacturerData", dbus.MakeVariant(manufacturerData)); err != nil {
+		return fmt.Errorf("bluetooth: could not set manufacturer data: %w", err)
+	}
+	return nil
+}
+
 func (a *Advertisement) handleDBusSignals() {
 	for {
 		select {
@@ -149,8 +208,10 @@ func (a *Advertisement) handleDBusSignals() {
 					continue
 				}
 
-				if connected, ok := props[bluezDevice1Connected].Value().(bool); ok {
-					a.adapter.connectHandler(device, connected)
+				// Scanned devices show up here too; only a device that
+				// is already connected is news to the connect handler.
+				if connected, _ := props[bluezDevice1Connected].Value().(bool); connected {
+					a.handleConnect(device, true)
 				}
 			case dbusSignalPropertiesChanged:
 				// Skip any signals that are not the Device1 interface.
@@ -180,20 +241,50 @@ func (a *Advertisement) handleDBusSignals() {
 						continue
 					}
 
-					a.adapter.connectHandler(device, connected)
+					a.handleConnect(device, connected)
 				}
 			}
 		}
 	}
 }
 
+// handleConnect enforces the access policy before passing a connection
+// change on to the connect handler. Refused devices are disconnected and
+// never reported as connected.
+func (a *Advertisement) handleConnect(device Device, connected bool) {
+	if !connected {
+		if a.adapter.allowed(device) {
+			a.adapter.connectHandler(device, false)
+		}
+		return
+	}
+	if !a.adapter.checkAccess(device, "connect") {
+		device.device.Call("org.bluez.Device1.Disconnect", 0)
+		return
+	}
+	a.adapter.connectHandler(device, true)
+}
+
 // Start advertisement. May only be called after it has been configured.
 func (a *Advertisement) Start() error {
+	return a.StartContext(context.Background())
+}
+
+// StartContext is like Start but gives up when ctx is done.
+func (a *Advertisement) StartContext(ctx context.Context) error {
+	powered, err := getProperty(ctx, a.adapter.adapter, bluezAdapter1Interface, "Powered")
+	if err != nil {
+		return fmt.Errorf("bluetooth: could not start advertisement: %w", err)
+	}
+	if on, _ := powered.Value().(bool); !on {
+		return ErrAdapterNotPowered
+	}
+
 	// Register our advertisement object to start advertising.
-	err := a.adapter.adapter.Call("org.bluez.LEAdvertisingManager1.RegisterAdvertisement", 0, a.path, map[string]interface{}{}).Err
+	err = wrapError(a.adapter.adapter.CallWithContext(ctx, "org.bluez.LEAdvertisingManager1.RegisterAdvertisement", 0, a.path, map[string]interface{}{}).Err)
 	if err != nil {
-		if err, ok := err.(dbus.Error); ok && err.Name == "org.bluez.Error.AlreadyExists" {
-			return errAdvertisementAlreadyStarted
+		if errors.Is(err, ErrAlreadyExists) {
+			return ErrAdvertisementAlreadyStarted
 		}
 		return fmt.Errorf("bluetooth: could not start advertisement: %w", err)
 	}
@@ -213,25 +304,27 @@ func (a *Advertisement) Start() error {
 		go a.handleDBusSignals()
 	}
 
-	// Make us discoverable.
-	err = a.adapter.adapter.SetProperty("org.bluez.Adapter1.Discoverable", dbus.MakeVariant(true))
-	if err != nil {
-		return fmt.Errorf("bluetooth: could not start advertisement: %w", err)
-	}
 	a.started = true
+	a.adapter.trackAdvertisement(a, true)
 	return nil
 }
 
 // Stop advertisement. May only be called after it has been started.
 func (a *Advertisement) Stop() error {
-	err := a.adapter.adapter.Call("org.bluez.LEAdvertisingManager1.UnregisterAdvertisement", 0, a.path).Err
+	return a.StopContext(context.Background())
+}
+
+// StopContext is like Stop but gives up when ctx is done.
+func (a *Advertisement) StopContext(ctx context.Context) error {
+	err := wrapError(a.adapter.adapter.CallWithContext(ctx, "org.bluez.LEAdvertisingManager1.UnregisterAdvertisement", 0, a.path).Err)
 	if err != nil {
-		if err, ok := err.(dbus.Error); ok && err.Name == "org.bluez.Error.DoesNotExist" {
-			return errAdvertisementNotStarted
+		if errors.Is(err, ErrDoesNotExist) {
+			return ErrAdvertisementNotStarted
 		}
 		return fmt.Errorf("bluetooth: could not stop advertisement: %w", err)
 	}
 	a.started = false
+	a.adapter.trackAdvertisement(a, false)
 
 	if a.sigCh != nil {
 		defer close(a.sigCh)
@@ -247,13 +340,18 @@ func (a *Advertisement) Stop() error {
 }
 
 func (d Device) Disconnect() error {
+	return d.DisconnectContext(context.Background())
+}
+
+// DisconnectContext is like Disconnect but gives up when ctx is done.
+func (d Device) DisconnectContext(ctx context.Context) error {
 	if d.adapter.connectHandler != nil {
 		d.adapter.connectHandler(d, false)
 	}
 
 	// we don't call our cancel function here, instead we wait for the
 	// property change in `watchForConnect` and cancel things then
-	return d.device.Call("org.bluez.Device1.Disconnect", 0).Err
+	return wrapError(d.device.CallWithContext(ctx, "org.bluez.Device1.Disconnect", 0).Err)
 }
 
 func (d *Device) parseProperties(props *map[string]dbus.Variant) error {
@@ -267,8 +365,41 @@ func (d *Device) parseProperties(props *map[string]dbus.Variant) error {
 				}
 				d.Address = Address{MACAddress: MACAddress{MAC: mac}}
 			}
+		case bluezDevice1AddressType:
+			d.AddressType, _ = v.Value().(string)
+		case bluezDevice1Name:
+			d.Name, _ = v.Value().(string)
+		case bluezDevice1Alias:
+			d.Alias, _ = v.Value().(string)
+		case bluezDevice1Connected:
+			d.Connected, _ = v.Value().(bool)
+		case bluezDevice1Paired:
+			d.Paired, _ = v.Value().(bool)
+		case bluezDevice1Bonded:
+			d.Bonded, _ = v.Value().(bool)
+		case bluezDevice1Trusted:
+			d.Trusted, _ = v.Value().(bool)
+		case bluezDevice1Blocked:
+			d.Blocked, _ = v.Value().(bool)
+		case bluezDevice1RSSI:
+			d.RSSI, _ = v.Value().(int16)
+		case bluezDevice1TxPower:
+			d.TxPower, _ = v.Value().(int16)
+		case bluezDevice1Appearance:
+			d.Appearance, _ = v.Value().(uint16)
+		case bluezDevice1UUIDs:
+			uuids, _ := v.Value().([]string)
+			d.UUIDs = d.UUIDs[:0]
+			for _, str := range uuids {
+				if uuid, err := ParseUUID(str); err == nil {
+					d.UUIDs = append(d.UUIDs, uuid)
+				}
+			}
 		}
 	}
+	if d.AddressType != "" {
+		d.Address.SetRandom(d.AddressType == "random")
+	}
 
 	return nil
 }
@@ -10,12 +10,17 @@ type Service struct {
 
 type WriteEvent = func(client Connection, offset int, value []byte)
 
+// ReadEvent returns the value a client reads, starting at offset. It lets a
+// characteristic show each client its own value.
+type ReadEvent = func(client Connection, offset int) ([]byte, error)
+
 type CharacteristicConfig struct {
 	Handle *Characteristic
 	UUID
 	Value      []byte
 	Flags      CharacteristicPermissions
 	WriteEvent WriteEvent
+	ReadEvent  ReadEvent
 }
 
 const (
@@ -1,8 +1,10 @@
 package bluetooth
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"sync/atomic"
 
 	"github.com/godbus/dbus/v5"
@@ -17,8 +19,14 @@ type Characteristic struct {
 }
 
 type blueZChar struct {
+	adapter    *Adapter
 	props      *prop.Properties
 	writeEvent func(client Connection, offset int, value []byte)
+	readEvent  ReadEvent
+	mtu        atomic.Uint32
+
+	mtuMu      sync.Mutex
+	clientMTUs map[Connection]uint16
 }
 
 type objectManager struct {
@@ -42,6 +50,11 @@ func (om *objectManager) GetManagedObjects() (map[dbus.ObjectPath]map[string]map
 }
 
 func (a *Adapter) AddService(s *Service) error {
+	return a.AddServiceContext(context.Background(), s)
+}
+
+// AddServiceContext is like AddService but gives up when ctx is done.
+func (a *Adapter) AddServiceContext(ctx context.Context, s *Service) error {
 	id := atomic.AddUint64(&serviceID, 1)
 	path := dbus.ObjectPath(fmt.Sprintf("/org/nbable/bluetooth/service%d", id))
 
@@ -88,8 +101,10 @@ func (a *Adapter) AddService(s *Service) error {
 		}
 
 		obj := &blueZChar{
+			adapter:    a,
 			props:      props,
 			writeEvent: char.WriteEvent,
+			readEvent:  char.ReadEvent,
 		}
 
 		err = a.bus.Export(obj, charPath, "org.bluez.GattCharacteristic1")
@@ -110,10 +125,23 @@ func (a *Adapter) AddService(s *Service) error {
 	if err != nil {
 		return err
 	}
-	return a.adapter.Call("org.bluez.GattManager1.RegisterApplication", 0, path, map[string]dbus.Variant(nil)).Err
+	err = wrapError(a.adapter.CallWithContext(ctx, "org.bluez.GattManager1.RegisterApplication", 0, path, map[string]dbus.Variant(nil)).Err)
+	if err != nil {
+		return err
+	}
+	a.trackApplication(path)
+	return nil
 }
 
 func (c *Characteristic) Write(p []byte) (n int, err error) {
+	return c.WriteContext(context.Background(), p)
+}
+
+// WriteContext is like Write but gives up when ctx is done.
+func (c *Characteristic) WriteContext(ctx context.Context, p []byte) (n int, err error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
 	if len(p) == 0 {
 		return 0, nil //nothing to do
 	}
@@ -128,14 +156,63 @@ func (c *Characteristic) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
+// MTU returns the ATT MTU BlueZ reported with the last read or write of the
+// characteristic, or 0 if it has not reported one yet.
+func (c *Characteristic) MTU() int {
+	return int(c.char.mtu.Load())
+}
+
+// ClientMTU returns the ATT MTU BlueZ reported with the last read or write
+// of the characteristic by client, or 0 if it has not reported one yet.
+func (c *Characteristic) ClientMTU(client Connection) int {
+	if c.char == nil {
+		return 0
+	}
+	c.char.mtuMu.Lock()
+	defer c.char.mtuMu.Unlock()
+	return int(c.char.clientMTUs[client])
+}
+
+func (c *blueZChar) trackMTU(options map[string]dbus.Variant) {
+	mtu, ok := options["mtu"].Value().(uint16)
+	if !ok {
+		return
+	}
+	c.mtu.Store(uint32(mtu))
+	if client := c.adapter.clientConnection(options); client != 0 {
+		c.mtuMu.Lock()
+		if c.clientMTUs == nil {
+			c.clientMTUs = make(map[Connection]uint16)
+		}
+		c.clientMTUs[client] = mtu
+		c.mtuMu.Unlock()
+	}
+}
+
 func (c *blueZChar) ReadValue(options map[string]dbus.Variant) ([]byte, *dbus.Error) {
+	if !c.adapter.checkAccessPath(options, "read") {
+		return nil, errNotAuthorized
+	}
+	c.trackMTU(options)
+	if c.readEvent != nil {
+		offset, _ := options["offset"].Value().(uint16)
+		value, err := c.readEvent(c.adapter.clientConnection(options), int(offset))
+		if err != nil {
+			return nil, dbus.MakeFailedError(err)
+		}
+		return value, nil
+	}
 	value := c.props.GetMust("org.bluez.GattCharacteristic1", "Value").([]byte)
 	return value, nil
 }
 
 func (c *blueZChar) WriteValue(value []byte, options map[string]dbus.Variant) *dbus.Error {
+	if !c.adapter.checkAccessPath(options, "write") {
+		return errNotAuthorized
+	}
+	c.trackMTU(options)
 	if c.writeEvent != nil {
-		client := Connection(0)
+		client := c.adapter.clientConnection(options)
 		offset, _ := options["offset"].Value().(uint16)
 		c.writeEvent(client, int(offset), value)
 	}
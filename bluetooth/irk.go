@@ -0,0 +1,117 @@
+package bluetooth
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+)
+
+// IRK is an identity resolving key, in the most significant byte first
+// order used by the Bluetooth Core specification.
+type IRK [16]byte
+
+var ErrInvalidIRK = errors.New("bluetooth: failed to parse IRK")
+
+// ParseIRK parses an IRK written as 32 hex digits, most significant first.
+func ParseIRK(s string) (irk IRK, err error) {
+	err = (&irk).UnmarshalText([]byte(s))
+	return
+}
+
+func (irk *IRK) UnmarshalText(s []byte) error {
+	if len(s) != 2*len(irk) {
+		return ErrInvalidIRK
+	}
+	if _, err := hex.Decode(irk[:], s); err != nil {
+		return ErrInvalidIRK
+	}
+	return nil
+}
+
+func (irk IRK) MarshalText() ([]byte, error) {
+	buf := make([]byte, 2*len(irk))
+	hex.Encode(buf, irk[:])
+	return buf, nil
+}
+
+func (irk IRK) String() string {
+	text, _ := irk.MarshalText()
+	return string(text)
+}
+
+// ah is the random address hash function from the Core specification,
+// Vol 3, Part H, 2.2.2. Only the lower 24 bits of r are used.
+//
+// With k = ec0234a357c8ad05341010a60a397d9b and r = 708194 it returns
+// 0dfbaa, as in the sample data of Vol 3, Part H, D.7.
+func ah(k IRK, r uint32) uint32 {
+	block, _ := aes.NewCipher(k[:]) // 16 byte key, cannot fail
+	var buf [16]byte
+	buf[13] = byte(r >> 16)
+	buf[14] = byte(r >> 8)
+	buf[15] = byte(r)
+	block.Encrypt(buf[:], buf[:])
+	return uint32(buf[13])<<16 | uint32(buf[14])<<8 | uint32(buf[15])
+}
+
+// Resolves reports whether addr is a resolvable private address generated
+// from this IRK.
+func (irk IRK) Resolves(addr MAC) bool {
+	// The upper 24 bits are prand, the lower 24 bits the hash.
+	prand := uint32(addr[5])<<16 | uint32(addr[4])<<8 | uint32(addr[3])
+	hash := uint32(addr[2])<<16 | uint32(addr[1])<<8 | uint32(addr[0])
+	if prand>>22 != 0b01 {
+		return false
+	}
+	return ah(irk, prand) == hash
+}
+
+// ResolveRPA returns the first IRK in irks that resolves addr.
+func ResolveRPA(addr MAC, irks []IRK) (IRK, bool) {
+	for _, irk := range irks {
+		if irk.Resolves(addr) {
+			return irk, true
+		}
+	}
+	return IRK{}, false
+}
+
+// ResolveIdentity maps a resolvable private address to the identity address
+// whose IRK resolves it. keys is typically loaded with LoadBlueZIRKs.
+func ResolveIdentity(addr MAC, keys map[MAC]IRK) (MAC, bool) {
+	for identity, irk := range keys {
+		if irk.Resolves(addr) {
+			return identity, true
+		}
+	}
+	return MAC{}, false
+}
+
+// GenerateRPA creates a new resolvable private address for irk.
+func GenerateRPA(irk IRK) (MACAddress, error) {
+	var prand uint32
+	for {
+		var buf [3]byte
+		if _, err := rand.Read(buf[:]); err != nil {
+			return MACAddress{}, err
+		}
+		prand = uint32(buf[0])<<16 | uint32(buf[1])<<8 | uint32(buf[2])
+		prand = prand&0x3fffff | 0b01<<22
+
+		// The random part of prand must not be all zeros or all ones.
+		if random := prand & 0x3fffff; random != 0 && random != 0x3fffff {
+			break
+		}
+	}
+	hash := ah(irk, prand)
+
+	var mac MAC
+	mac[0] = byte(hash)
+	mac[1] = byte(hash >> 8)
+	mac[2] = byte(hash >> 16)
+	mac[3] = byte(prand)
+	mac[4] = byte(prand >> 8)
+	mac[5] = byte(prand >> 16)
+	return MACAddress{MAC: mac, isRandom: true}, nil
+}
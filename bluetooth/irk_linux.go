@@ -0,0 +1,90 @@
+package bluetooth
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// bluezStorageDir is where bluetoothd keeps pairing information.
+const bluezStorageDir = "/var/lib/bluetooth"
+
+// LoadBlueZIRKs reads the IRKs of all bonded devices of the given adapter
+// from /var/lib/bluetooth/<adapter>/<device>/info. The map is keyed by the
+// identity address of each device. Reading these files requires root.
+func LoadBlueZIRKs(adapter MAC) (map[MAC]IRK, error) {
+	dir := filepath.Join(bluezStorageDir, adapter.String())
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[MAC]IRK)
+	for _, entry := range entries {
+		identity, err := ParseMAC(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue // e.g. the "cache" directory or the "settings" file
+		}
+		irk, err := readBlueZIRK(filepath.Join(dir, entry.Name(), "info"))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) || errors.Is(err, errNoIRK) {
+				continue
+			}
+			return nil, err
+		}
+		keys[identity] = irk
+	}
+	return keys, nil
+}
+
+// LoadIRKs is like LoadBlueZIRKs for this adapter.
+func (a *Adapter) LoadIRKs() (map[MAC]IRK, error) {
+	addr, err := a.Address()
+	if err != nil {
+		return nil, err
+	}
+	return LoadBlueZIRKs(addr.MAC)
+}
+
+var errNoIRK = errors.New("bluetooth: no IRK stored for device")
+
+// readBlueZIRK extracts the Key entry of the [IdentityResolvingKey] group.
+// BlueZ writes the key least significant byte first.
+func readBlueZIRK(path string) (IRK, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return IRK{}, err
+	}
+	defer f.Close()
+
+	var group string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			group = line[1 : len(line)-1]
+			continue
+		}
+		if group != "IdentityResolvingKey" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || strings.TrimSpace(key) != "Key" {
+			continue
+		}
+		irk, err := ParseIRK(strings.TrimSpace(value))
+		if err != nil {
+			return IRK{}, err
+		}
+		for i, j := 0, len(irk)-1; i < j; i, j = i+1, j-1 {
+			irk[i], irk[j] = irk[j], irk[i]
+		}
+		return irk, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return IRK{}, err
+	}
+	return IRK{}, errNoIRK
+}
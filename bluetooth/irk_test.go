@@ -0,0 +1,84 @@
+package bluetooth
+
+import "testing"
+
+// Sample data from the Core specification, Vol 3, Part H, D.7.
+const (
+	sampleIRK   = "ec0234a357c8ad05341010a60a397d9b"
+	samplePrand = 0x708194
+	sampleHash  = 0x0dfbaa
+)
+
+func TestAh(t *testing.T) {
+	irk, err := ParseIRK(sampleIRK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash := ah(irk, samplePrand); hash != sampleHash {
+		t.Errorf("ah(%s, %06x) = %06x, want %06x", irk, samplePrand, hash, sampleHash)
+	}
+}
+
+func TestResolvesSampleAddress(t *testing.T) {
+	irk, err := ParseIRK(sampleIRK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// prand in the upper half, hash in the lower half.
+	addr, err := ParseMAC("70:81:94:0D:FB:AA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !irk.Resolves(addr) {
+		t.Errorf("%s does not resolve %s", irk, addr)
+	}
+
+	addr[0] ^= 1
+	if irk.Resolves(addr) {
+		t.Errorf("%s resolves corrupted address %s", irk, addr)
+	}
+}
+
+func TestGenerateRPARoundTrip(t *testing.T) {
+	irk, err := ParseIRK(sampleIRK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ParseIRK("00112233445566778899aabbccddeeff")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100; i++ {
+		addr, err := GenerateRPA(irk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !addr.IsResolvablePrivate() {
+			t.Fatalf("GenerateRPA returned %s, which is not a resolvable private address", addr.MAC)
+		}
+		got, ok := ResolveRPA(addr.MAC, []IRK{other, irk})
+		if !ok || got != irk {
+			t.Fatalf("ResolveRPA(%s) = %s, %v, want %s, true", addr.MAC, got, ok, irk)
+		}
+		if _, ok := ResolveRPA(addr.MAC, []IRK{other}); ok {
+			t.Fatalf("ResolveRPA(%s) resolved with the wrong IRK", addr.MAC)
+		}
+	}
+}
+
+func TestParseIRK(t *testing.T) {
+	for _, s := range []string{"", "ec0234a357c8ad05341010a60a397d9", "ec0234a357c8ad05341010a60a397d9bff", "zz0234a357c8ad05341010a60a397d9b"} {
+		if _, err := ParseIRK(s); err != ErrInvalidIRK {
+			t.Errorf("ParseIRK(%q) error = %v, want %v", s, err, ErrInvalidIRK)
+		}
+	}
+
+	irk, err := ParseIRK(sampleIRK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := irk.String(); got != sampleIRK {
+		t.Errorf("String() = %q, want %q", got, sampleIRK)
+	}
+}
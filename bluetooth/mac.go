@@ -16,11 +16,30 @@ func ParseMAC(s string) (mac MAC, err error) {
 	return
 }
 
+// UnmarshalText parses a MAC address written as six hex octets, most
+// significant first. Octets may be separated by colons or dashes or not at
+// all, and both upper and lower case digits are accepted.
 func (mac *MAC) UnmarshalText(s []byte) error {
+	var sep byte
+	switch len(s) {
+	case 12:
+	case 17:
+		sep = s[2]
+		if sep != ':' && sep != '-' {
+			return ErrInvalidMAC
+		}
+	default:
+		return ErrInvalidMAC
+	}
+
+	var parsed MAC
 	macIndex := 11
 	for i := 0; i < len(s); i++ {
 		c := s[i]
-		if c == ':' {
+		if sep != 0 && i%3 == 2 {
+			if c != sep {
+				return ErrInvalidMAC
+			}
 			continue
 		}
 		var nibble byte
@@ -28,22 +47,34 @@ func (mac *MAC) UnmarshalText(s []byte) error {
 			nibble = c - '0' + 0x0
 		} else if c >= 'A' && c <= 'F' {
 			nibble = c - 'A' + 0xA
+		} else if c >= 'a' && c <= 'f' {
+			nibble = c - 'a' + 0xA
 		} else {
 			return ErrInvalidMAC
 		}
-		if macIndex < 0 {
-			return ErrInvalidMAC
-		}
 		if macIndex%2 == 0 {
-			mac[macIndex/2] |= nibble
+			parsed[macIndex/2] |= nibble
 		} else {
-			mac[macIndex/2] |= nibble << 4
+			parsed[macIndex/2] |= nibble << 4
 		}
 		macIndex--
 	}
-	if macIndex != -1 {
+	*mac = parsed
+	return nil
+}
+
+// MarshalBinary returns the six address bytes in the little-endian order
+// used on air and by HCI.
+func (mac MAC) MarshalBinary() ([]byte, error) {
+	return mac[:], nil
+}
+
+// UnmarshalBinary is the inverse of MarshalBinary.
+func (mac *MAC) UnmarshalBinary(data []byte) error {
+	if len(data) != len(mac) {
 		return ErrInvalidMAC
 	}
+	copy(mac[:], data)
 	return nil
 }
 
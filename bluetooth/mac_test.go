@@ -0,0 +1,97 @@
+package bluetooth
+
+import "testing"
+
+func TestParseMAC(t *testing.T) {
+	want := MAC{0x66, 0x55, 0x44, 0x33, 0x22, 0x11}
+	for _, s := range []string{
+		"11:22:33:44:55:66",
+		"11-22-33-44-55-66",
+		"112233445566",
+	} {
+		got, err := ParseMAC(s)
+		if err != nil {
+			t.Errorf("ParseMAC(%q) error = %v", s, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseMAC(%q) = %v, want %v", s, got, want)
+		}
+	}
+
+	mac, err := ParseMAC("aa:bb:cc:dd:ee:ff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mac.String(); got != "AA:BB:CC:DD:EE:FF" {
+		t.Errorf("String() = %q, want %q", got, "AA:BB:CC:DD:EE:FF")
+	}
+}
+
+func TestParseMACInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"11:22:33:44:55",
+		"11:22:33:44:55:66:77",
+		"11:22-33:44:55:66",
+		"11.22.33.44.55.66",
+		"1122334455gg",
+		"11:22:33:44:55:6",
+	} {
+		if _, err := ParseMAC(s); err != ErrInvalidMAC {
+			t.Errorf("ParseMAC(%q) error = %v, want %v", s, err, ErrInvalidMAC)
+		}
+	}
+}
+
+func TestMACBinary(t *testing.T) {
+	mac := MAC{1, 2, 3, 4, 5, 6}
+	data, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got MAC
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got != mac {
+		t.Errorf("UnmarshalBinary(MarshalBinary()) = %v, want %v", got, mac)
+	}
+	if err := got.UnmarshalBinary(data[:5]); err != ErrInvalidMAC {
+		t.Errorf("UnmarshalBinary of 5 bytes error = %v, want %v", err, ErrInvalidMAC)
+	}
+}
+
+func TestMACAddressType(t *testing.T) {
+	tests := []struct {
+		addr                              string
+		random                            bool
+		static, resolvable, nonResolvable bool
+	}{
+		{"00:1A:7D:DA:71:13", false, false, false, false},
+		{"C0:1A:7D:DA:71:13", false, false, false, false},
+		{"C0:1A:7D:DA:71:13", true, true, false, false},
+		{"40:1A:7D:DA:71:13", true, false, true, false},
+		{"00:1A:7D:DA:71:13", true, false, false, true},
+	}
+	for _, tt := range tests {
+		mac, err := ParseMAC(tt.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr := MACAddress{MAC: mac}
+		addr.SetRandom(tt.random)
+		if addr.IsPublic() == tt.random || addr.IsRandom() != tt.random {
+			t.Errorf("%s random=%v: IsPublic() = %v, IsRandom() = %v", tt.addr, tt.random, addr.IsPublic(), addr.IsRandom())
+		}
+		if got := addr.IsRandomStatic(); got != tt.static {
+			t.Errorf("%s random=%v: IsRandomStatic() = %v, want %v", tt.addr, tt.random, got, tt.static)
+		}
+		if got := addr.IsResolvablePrivate(); got != tt.resolvable {
+			t.Errorf("%s random=%v: IsResolvablePrivate() = %v, want %v", tt.addr, tt.random, got, tt.resolvable)
+		}
+		if got := addr.IsNonResolvablePrivate(); got != tt.nonResolvable {
+			t.Errorf("%s random=%v: IsNonResolvablePrivate() = %v, want %v", tt.addr, tt.random, got, tt.nonResolvable)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/prop"
+)
+
+const bluezAdvertisementMonitor1Interface = "org.bluez.AdvertisementMonitor1"
+
+var monitorID uint64
+
+// MonitorPattern matches advertising data: the AD structure of type ADType
+// must contain Content starting at Offset.
+type MonitorPattern struct {
+	Offset  byte
+	ADType  byte
+	Content []byte
+}
+
+// MonitorOptions configures an advertisement monitor. A device is found
+// when any of the patterns matches and its RSSI stays at or above
+// HighRSSI for HighTimeout, and lost when its RSSI stays at or below
+// LowRSSI for LowTimeout. Zero thresholds leave the choice to BlueZ.
+type MonitorOptions struct {
+	Patterns []MonitorPattern
+
+	HighRSSI    int16
+	HighTimeout time.Duration
+	LowRSSI     int16
+	LowTimeout  time.Duration
+	// SamplingPeriod limits how often matching advertisements are reported
+	// while a device is tracked. Zero reports all of them.
+	SamplingPeriod time.Duration
+
+	DeviceFound func(device Device)
+	DeviceLost  func(device Device)
+	// Released is called when BlueZ stops using the monitor, for example
+	// because the controller cannot offload it.
+	Released func()
+}
+
+// AdvertisementMonitor is a passive scan filter run by BlueZ, and offloaded
+// to the controller where supported, which is much cheaper than a full
+// discovery and can run while the adapter is advertising.
+type AdvertisementMonitor struct {
+	adapter *Adapter
+	options MonitorOptions
+	path    dbus.ObjectPath
+}
+
+// monitorObject is exported as org.bluez.AdvertisementMonitor1.
+type monitorObject struct {
+	m *AdvertisementMonitor
+}
+
+func (o monitorObject) Release() *dbus.Error {
+	if o.m.options.Released != nil {
+		o.m.options.Released()
+	}
+	return nil
+}
+
+func (o monitorObject) Activate() *dbus.Error {
+	return nil
+}
+
+func (o monitorObject) DeviceFound(device dbus.ObjectPath) *dbus.Error {
+	if o.m.options.DeviceFound != nil {
+		o.m.options.DeviceFound(o.m.device(device))
+	}
+	return nil
+}
+
+func (o monitorObject) DeviceLost(device dbus.ObjectPath) *dbus.Error {
+	if o.m.options.DeviceLost != nil {
+		o.m.options.DeviceLost(o.m.device(device))
+	}
+	return nil
+}
+
+func (m *AdvertisementMonitor) device(path dbus.ObjectPath) Device {
+	device := Device{
+		device:  m.adapter.bus.Object("org.bluez", path),
+		adapter: m.adapter,
+	}
+	var props map[string]dbus.Variant
+	if err := device.device.Call("org.freedesktop.DBus.Properties.GetAll", 0, bluezDevice1Interface).Store(&props); err == nil {
+		device.parseProperties(&props)
+	}
+	return device
+}
+
+// AddMonitor registers a passive advertisement monitor. BlueZ requires the
+// monitors of an application to live under an object manager, so each
+// monitor gets its own application root.
+func (a *Adapter) AddMonitor(ctx context.Context, options MonitorOptions) (*AdvertisementMonitor, error) {
+	if len(options.Patterns) == 0 {
+		return nil, fmt.Errorf("bluetooth: advertisement monitor needs at least one pattern")
+	}
+
+	id := atomic.AddUint64(&monitorID, 1)
+	root := dbus.ObjectPath(fmt.Sprintf("/org/nbable/bluetooth/monitor%d", id))
+	m := &AdvertisementMonitor{
+		adapter: a,
+		options: options,
+		path:    root + "/monitor0",
+	}
+
+	type pattern struct {
+		Offset  byte
+		ADType  byte
+		Content []byte
+	}
+	var patterns []pattern
+	for _, p := range options.Patterns {
+		patterns = append(patterns, pattern{p.Offset, p.ADType, p.Content})
+	}
+
+	props := map[string]*prop.Prop{
+		"Type":     {Value: "or_patterns"},
+		"Patterns": {Value: patterns},
+	}
+	// BlueZ treats properties that are missing as "use the default", which
+	// is different from a zero value, so only send what was set.
+	if options.HighRSSI != 0 {
+		props["RSSIHighThreshold"] = &prop.Prop{Value: options.HighRSSI}
+	}
+	if options.LowRSSI != 0 {
+		props["RSSILowThreshold"] = &prop.Prop{Value: options.LowRSSI}
+	}
+	if options.HighTimeout != 0 {
+		props["RSSIHighTimeout"] = &prop.Prop{Value: uint16(options.HighTimeout / time.Second)}
+	}
+	if options.LowTimeout != 0 {
+		props["RSSILowTimeout"] = &prop.Prop{Value: uint16(options.LowTimeout / time.Second)}
+	}
+	if options.SamplingPeriod != 0 {
+		// Expressed in units of 100 ms.
+		props["RSSISamplingPeriod"] = &prop.Prop{Value: uint16(options.SamplingPeriod / (100 * time.Millisecond))}
+	}
+	propsSpec := map[string]map[string]*prop.Prop{
+		bluezAdvertisementMonitor1Interface: props,
+	}
+
+	if _, err := prop.Export(a.bus, m.path, propsSpec); err != nil {
+		return nil, err
+	}
+	if err := a.bus.Export(monitorObject{m}, m.path, bluezAdvertisementMonitor1Interface); err != nil {
+		return nil, err
+	}
+	om := &objectManager{
+		objects: map[dbus.ObjectPath]map[string]map[string]*prop.Prop{m.path: propsSpec},
+	}
+	if err := a.bus.Export(om, root, "org.freedesktop.DBus.ObjectManager"); err != nil {
+		return nil, err
+	}
+
+	err := a.adapter.CallWithContext(ctx, "org.bluez.AdvertisementMonitorManager1.RegisterMonitor", 0, root).Err
+	if err != nil {
+		m.unexport()
+		return nil, fmt.Errorf("bluetooth: could not register advertisement monitor: %w", wrapError(err))
+	}
+	a.trackMonitor(root)
+	return m, nil
+}
+
+// Remove unregisters the monitor.
+func (m *AdvertisementMonitor) Remove(ctx context.Context) error {
+	root := m.root()
+	m.adapter.untrackMonitor(root)
+	err := m.adapter.adapter.CallWithContext(ctx, "org.bluez.AdvertisementMonitorManager1.UnregisterMonitor", 0, root).Err
+	m.unexport()
+	if err != nil {
+		return fmt.Errorf("bluetooth: could not unregister advertisement monitor: %w", wrapError(err))
+	}
+	return nil
+}
+
+func (m *AdvertisementMonitor) root() dbus.ObjectPath {
+	return m.path[:len(m.path)-len("/monitor0")]
+}
+
+func (m *AdvertisementMonitor) unexport() {
+	m.adapter.bus.Export(nil, m.path, bluezAdvertisementMonitor1Interface)
+	m.adapter.bus.Export(nil, m.path, "org.freedesktop.DBus.Properties")
+	m.adapter.bus.Export(nil, m.root(), "org.freedesktop.DBus.ObjectManager")
+}
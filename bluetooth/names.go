@@ -0,0 +1,27 @@
+package bluetooth
+
+import "github.com/mikoaf/mikoafble/bluetooth/assignednumbers"
+
+// Name returns the SIG assigned name of a 16-bit UUID, such as "Battery" for
+// 0000180f-0000-1000-8000-00805f9b34fb, or an empty string if it has none.
+func (u UUID) Name() string {
+	if !u.Is16Bit() {
+		return ""
+	}
+	name, _ := assignednumbers.UUIDName(u.Get16Bit())
+	return name
+}
+
+// CompanyName returns the name of the company with the given identifier, as
+// found in ManufacturerDataElement.CompanyID, or an empty string if unknown.
+func CompanyName(id uint16) string {
+	name, _ := assignednumbers.CompanyName(id)
+	return name
+}
+
+// AppearanceName returns the name of an appearance value, such as
+// Device.Appearance, or an empty string if unknown.
+func AppearanceName(appearance uint16) string {
+	name, _ := assignednumbers.AppearanceName(appearance)
+	return name
+}
@@ -0,0 +1,174 @@
+package bluetooth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	dbusSignalNameOwnerChanged = "org.freedesktop.DBus.NameOwnerChanged"
+
+	dbusNameOwnerChangedNewOwner = 2
+
+	// How long to keep trying to re-register after bluetoothd came back. The
+	// adapter object usually shows up a little after the bus name.
+	recoveryTimeout  = 30 * time.Second
+	recoveryInterval = 500 * time.Millisecond
+)
+
+var matchOptionsNameOwnerChanged = []dbus.MatchOption{dbus.WithMatchSender("org.freedesktop.DBus"),
+	dbus.WithMatchInterface("org.freedesktop.DBus"),
+	dbus.WithMatchMember("NameOwnerChanged"),
+	dbus.WithMatchArg(0, "org.bluez")}
+
+// RecoveryEvent reports bluetoothd dropping off the bus or coming back.
+type RecoveryEvent struct {
+	// Lost is true when bluetoothd went away, and false once it is back and
+	// the registrations have been restored.
+	Lost bool
+	// Services, Advertisements and Monitors are the number of
+	// registrations that were restored.
+	Services       int
+	Advertisements int
+	Monitors       int
+	// Err is set when the registrations could not all be restored.
+	Err error
+}
+
+// SetRecoveryHandler sets a callback that is invoked when bluetoothd
+// restarts. All services added with AddService, all started advertisements
+// and all advertisement monitors are registered again automatically.
+func (a *Adapter) SetRecoveryHandler(handler func(event RecoveryEvent)) {
+	a.recoveryHandler = handler
+}
+
+func (a *Adapter) trackApplication(path dbus.ObjectPath) {
+	a.mu.Lock()
+	a.applications = append(a.applications, path)
+	a.mu.Unlock()
+}
+
+func (a *Adapter) trackMonitor(root dbus.ObjectPath) {
+	a.mu.Lock()
+	a.monitors = append(a.monitors, root)
+	a.mu.Unlock()
+}
+
+func (a *Adapter) untrackMonitor(root dbus.ObjectPath) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, other := range a.monitors {
+		if other == root {
+			a.monitors = append(a.monitors[:i], a.monitors[i+1:]...)
+			return
+		}
+	}
+}
+
+func (a *Adapter) trackAdvertisement(adv *Advertisement, started bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, other := range a.advertisements {
+		if other == adv {
+			a.advertisements = append(a.advertisements[:i], a.advertisements[i+1:]...)
+			break
+		}
+	}
+	if started {
+		a.advertisements = append(a.advertisements, adv)
+	}
+}
+
+func (a *Adapter) startBlueZWatch() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.bluezSigCh != nil {
+		return nil
+	}
+	if err := a.bus.AddMatchSignal(matchOptionsNameOwnerChanged...); err != nil {
+		return fmt.Errorf("bluetooth: add dbus match signal: NameOwnerChanged: %w", err)
+	}
+	a.bluezSigCh = make(chan *dbus.Signal, 4)
+	a.bus.Signal(a.bluezSigCh)
+	go a.watchBlueZ(a.bluezSigCh)
+	return nil
+}
+
+func (a *Adapter) watchBlueZ(sigCh chan *dbus.Signal) {
+	for sig := range sigCh {
+		if sig.Name != dbusSignalNameOwnerChanged || len(sig.Body) <= dbusNameOwnerChangedNewOwner {
+			continue
+		}
+		if name, _ := sig.Body[0].(string); name != "org.bluez" {
+			continue
+		}
+		if owner, _ := sig.Body[dbusNameOwnerChangedNewOwner].(string); owner == "" {
+			a.recoveryHandler(RecoveryEvent{Lost: true})
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), recoveryTimeout)
+		a.recoveryHandler(a.reregister(ctx))
+		cancel()
+	}
+}
+
+// reregister registers all known GATT applications and advertisements
+// again, retrying while the new bluetoothd instance is still starting up.
+func (a *Adapter) reregister(ctx context.Context) RecoveryEvent {
+	a.mu.Lock()
+	applications := append([]dbus.ObjectPath(nil), a.applications...)
+	advertisements := append([]*Advertisement(nil), a.advertisements...)
+	monitors := append([]dbus.ObjectPath(nil), a.monitors...)
+	a.mu.Unlock()
+
+	var event RecoveryEvent
+	for _, path := range applications {
+		err := a.retry(ctx, func() error {
+			return a.adapter.CallWithContext(ctx, "org.bluez.GattManager1.RegisterApplication", 0, path, map[string]dbus.Variant(nil)).Err
+		})
+		if err != nil {
+			event.Err = fmt.Errorf("bluetooth: could not restore service %s: %w", path, err)
+			return event
+		}
+		event.Services++
+	}
+	for _, adv := range advertisements {
+		err := a.retry(ctx, func() error {
+			return adv.adapter.adapter.CallWithContext(ctx, "org.bluez.LEAdvertisingManager1.RegisterAdvertisement", 0, adv.path, map[string]interface{}{}).Err
+		})
+		if err != nil {
+			event.Err = fmt.Errorf("bluetooth: could not restore advertisement %s: %w", adv.path, err)
+			return event
+		}
+		event.Advertisements++
+	}
+	for _, root := range monitors {
+		err := a.retry(ctx, func() error {
+			return a.adapter.CallWithContext(ctx, "org.bluez.AdvertisementMonitorManager1.RegisterMonitor", 0, root).Err
+		})
+		if err != nil {
+			event.Err = fmt.Errorf("bluetooth: could not restore advertisement monitor %s: %w", root, err)
+			return event
+		}
+		event.Monitors++
+	}
+	return event
+}
+
+func (a *Adapter) retry(ctx context.Context, fn func() error) error {
+	for {
+		err := wrapError(fn())
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(recoveryInterval):
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package bluetooth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	bluezDevice1ManufacturerData = "ManufacturerData"
+	bluezDevice1ServiceData      = "ServiceData"
+)
+
+var ErrScanInProgress = errors.New("bluetooth: scan already in progress")
+
+// Scan starts LE discovery and calls callback for every advertisement
+// received until ctx is done. Duplicate advertisements are reported too, so
+// RSSI updates keep coming for devices that stay in range.
+func (a *Adapter) Scan(ctx context.Context, callback func(result ScanResult)) error {
+	a.mu.Lock()
+	if a.scanCancelChan != nil {
+		a.mu.Unlock()
+		return ErrScanInProgress
+	}
+	cancel := make(chan struct{})
+	a.scanCancelChan = cancel
+	a.mu.Unlock()
+	defer func() {
+		a.mu.Lock()
+		a.scanCancelChan = nil
+		a.mu.Unlock()
+	}()
+
+	filter := map[string]interface{}{
+		"Transport":     "le",
+		"DuplicateData": true,
+	}
+	if err := a.adapter.CallWithContext(ctx, "org.bluez.Adapter1.SetDiscoveryFilter", 0, filter).Err; err != nil {
+		return fmt.Errorf("bluetooth: could not set discovery filter: %w", wrapError(err))
+	}
+
+	matchOptionsDeviceChanged := []dbus.MatchOption{dbus.WithMatchInterface("org.freedesktop.DBus.Properties"),
+		dbus.WithMatchMember("PropertiesChanged"),
+		dbus.WithMatchPathNamespace(a.adapter.Path()),
+		dbus.WithMatchArg(dbusPropertiesChangedInterfaceName, bluezDevice1Interface)}
+	if err := a.bus.AddMatchSignalContext(ctx, matchOptionsDeviceChanged...); err != nil {
+		return fmt.Errorf("bluetooth: add dbus match signal: PropertiesChanged: %w", err)
+	}
+	defer a.bus.RemoveMatchSignal(matchOptionsDeviceChanged...)
+	// D-Bus match rules are not reference-counted, so the object manager
+	// rules are scoped to the devices of this adapter. Removing them must
+	// not remove the rules of a running advertisement.
+	matchOptionsDeviceAdded := a.matchOptionsDevices(matchOptionsInterfacesAdded)
+	if err := a.bus.AddMatchSignalContext(ctx, matchOptionsDeviceAdded...); err != nil {
+		return fmt.Errorf("bluetooth: add dbus match signal: InterfacesAdded: %w", err)
+	}
+	defer a.bus.RemoveMatchSignal(matchOptionsDeviceAdded...)
+	matchOptionsDeviceRemoved := a.matchOptionsDevices(matchOptionsInterfacesRemoved)
+	if err := a.bus.AddMatchSignalContext(ctx, matchOptionsDeviceRemoved...); err != nil {
+		return fmt.Errorf("bluetooth: add dbus match signal: InterfacesRemoved: %w", err)
+	}
+	defer a.bus.RemoveMatchSignal(matchOptionsDeviceRemoved...)
+
+	sigCh := make(chan *dbus.Signal, 32)
+	a.bus.Signal(sigCh)
+	defer a.bus.RemoveSignal(sigCh)
+
+	if err := a.adapter.CallWithContext(ctx, "org.bluez.Adapter1.StartDiscovery", 0).Err; err != nil {
+		return fmt.Errorf("bluetooth: could not start discovery: %w", wrapError(err))
+	}
+	defer a.adapter.Call("org.bluez.Adapter1.StopDiscovery", 0)
+
+	// BlueZ only sends the properties that changed, so keep the last known
+	// state of every device to report complete results. Devices are dropped
+	// again when BlueZ removes them, which it does for stale private
+	// addresses.
+	devices := make(map[dbus.ObjectPath]map[string]dbus.Variant)
+	adapterPrefix := string(a.adapter.Path()) + "/"
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-cancel:
+			return nil
+		case sig, ok := <-sigCh:
+			if !ok || sig == nil {
+				return fmt.Errorf("bluetooth: scan stopped: %w", dbus.ErrClosed)
+			}
+			switch sig.Name {
+			case dbusSignalInterfacesAdded:
+				path, ok := sig.Body[0].(dbus.ObjectPath)
+				if !ok || !strings.HasPrefix(string(path), adapterPrefix) {
+					continue
+				}
+				interfaces, ok := sig.Body[dbusInterfacesAddedDictionary].(map[string]map[string]dbus.Variant)
+				if !ok {
+					continue
+				}
+				props, ok := interfaces[bluezDevice1Interface]
+				if !ok {
+					continue
+				}
+				devices[path] = props
+				if result, ok := parseScanResult(props); ok {
+					callback(result)
+				}
+			case dbusSignalInterfacesRemoved:
+				path, ok := sig.Body[0].(dbus.ObjectPath)
+				if !ok {
+					continue
+				}
+				removed, ok := sig.Body[dbusInterfacesRemovedList].([]string)
+				if !ok {
+					continue
+				}
+				for _, name := range removed {
+					if name == bluezDevice1Interface {
+						delete(devices, path)
+					}
+				}
+			case dbusSignalPropertiesChanged:
+				if !strings.HasPrefix(string(sig.Path), adapterPrefix) {
+					continue
+				}
+				if interfaceName, ok := sig.Body[dbusPropertiesChangedInterfaceName].(string); !ok || interfaceName != bluezDevice1Interface {
+					continue
+				}
+				changes, ok := sig.Body[dbusPropertiesChangedDictionary].(map[string]dbus.Variant)
+				if !ok {
+					continue
+				}
+				props, ok := devices[sig.Path]
+				if !ok {
+					if err := a.bus.Object("org.bluez", sig.Path).Call("org.freedesktop.DBus.Properties.GetAll", 0, bluezDevice1Interface).Store(&props); err != nil {
+						continue
+					}
+					devices[sig.Path] = props
+				}
+				for k, v := range changes {
+					props[k] = v
+				}
+				// Only advertisements carry RSSI or data; skip changes such as
+				// Connected or Paired.
+				_, rssi := changes[bluezDevice1RSSI]
+				_, mfg := changes[bluezDevice1ManufacturerData]
+				_, svc := changes[bluezDevice1ServiceData]
+				if !rssi && !mfg && !svc {
+					continue
+				}
+				if result, ok := parseScanResult(props); ok {
+					callback(result)
+				}
+			}
+		}
+	}
+}
+
+// matchOptionsDevices narrows an object manager match to signals about
+// objects below the adapter.
+func (a *Adapter) matchOptionsDevices(options []dbus.MatchOption) []dbus.MatchOption {
+	return append(append([]dbus.MatchOption(nil), options...),
+		dbus.WithMatchArgPath(0, string(a.adapter.Path())+"/"))
+}
+
+// StopScan ends a scan started with Scan.
+func (a *Adapter) StopScan() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.scanCancelChan == nil {
+		return errors.New("bluetooth: no scan in progress")
+	}
+	close(a.scanCancelChan)
+	return nil
+}
+
+func parseScanResult(props map[string]dbus.Variant) (ScanResult, bool) {
+	var device Device
+	if err := device.parseProperties(&props); err != nil {
+		return ScanResult{}, false
+	}
+	if _, ok := props[bluezDevice1RSSI]; !ok {
+		// Cached devices that were not seen during this scan.
+		return ScanResult{}, false
+	}
+
+	result := ScanResult{
+		Address:      device.Address,
+		RSSI:         device.RSSI,
+		LocalName:    device.Name,
+		ServiceUUIDs: device.UUIDs,
+		TxPower:      device.TxPower,
+	}
+
+	if mfg, ok := props[bluezDevice1ManufacturerData].Value().(map[uint16]dbus.Variant); ok {
+		for id, v := range mfg {
+			if data, ok := v.Value().([]byte); ok {
+				result.ManufacturerData = append(result.ManufacturerData, ManufacturerDataElement{CompanyID: id, Data: data})
+			}
+		}
+		sort.Slice(result.ManufacturerData, func(i, j int) bool {
+			return result.ManufacturerData[i].CompanyID < result.ManufacturerData[j].CompanyID
+		})
+	}
+	if svc, ok := props[bluezDevice1ServiceData].Value().(map[string]dbus.Variant); ok {
+		for uuidStr, v := range svc {
+			uuid, err := ParseUUID(uuidStr)
+			if err != nil {
+				continue
+			}
+			if data, ok := v.Value().([]byte); ok {
+				result.ServiceData = append(result.ServiceData, ServiceDataElement{UUID: uuid, Data: data})
+			}
+		}
+	}
+	return result, true
+}
@@ -1,6 +1,10 @@
 package bluetooth
 
-import "unsafe"
+import (
+	"encoding/binary"
+	"errors"
+	"unsafe"
+)
 
 type UUID [4]uint32
 
@@ -50,3 +54,138 @@ func (u UUID) AppendText(buf []byte) ([]byte, error) {
 
 	return buf, nil
 }
+
+var ErrInvalidUUID = errors.New("bluetooth: failed to parse UUID")
+
+// The Bluetooth base UUID, 00000000-0000-1000-8000-00805f9b34fb. 16-bit and
+// 32-bit UUIDs replace its first 32 bits.
+var baseUUID = UUID{0x5f9b34fb, 0x80000080, 0x00001000, 0x00000000}
+
+// New16BitUUID returns the 128-bit form of a 16-bit SIG assigned UUID.
+func New16BitUUID(shortUUID uint16) UUID {
+	return New32BitUUID(uint32(shortUUID))
+}
+
+// New32BitUUID returns the 128-bit form of a 32-bit SIG assigned UUID.
+func New32BitUUID(shortUUID uint32) UUID {
+	u := baseUUID
+	u[3] = shortUUID
+	return u
+}
+
+// Is32Bit reports whether the UUID is based on the Bluetooth base UUID and
+// can be sent in its 32-bit form.
+func (u UUID) Is32Bit() bool {
+	return u[0] == baseUUID[0] && u[1] == baseUUID[1] && u[2] == baseUUID[2]
+}
+
+// Is16Bit reports whether the UUID can be sent in its 16-bit form.
+func (u UUID) Is16Bit() bool {
+	return u.Is32Bit() && u[3] <= 0xffff
+}
+
+// Get16Bit returns the 16-bit form. It is only meaningful if Is16Bit is true.
+func (u UUID) Get16Bit() uint16 {
+	return uint16(u[3])
+}
+
+// Get32Bit returns the 32-bit form. It is only meaningful if Is32Bit is true.
+func (u UUID) Get32Bit() uint32 {
+	return u[3]
+}
+
+// ParseUUID parses a UUID in its 128-bit form, with or without hyphens, or
+// a 16-bit or 32-bit SIG assigned UUID written as 4 or 8 hex digits.
+func ParseUUID(s string) (u UUID, err error) {
+	err = (&u).UnmarshalText([]byte(s))
+	return
+}
+
+func (u *UUID) UnmarshalText(s []byte) error {
+	switch len(s) {
+	case 4, 8:
+		var short uint32
+		for _, c := range s {
+			nibble, ok := hexNibble(c)
+			if !ok {
+				return ErrInvalidUUID
+			}
+			short = short<<4 | uint32(nibble)
+		}
+		*u = New32BitUUID(short)
+		return nil
+	case 32, 36:
+	default:
+		return ErrInvalidUUID
+	}
+
+	var parsed UUID
+	digit := 0
+	for i, c := range s {
+		if len(s) == 36 && (i == 8 || i == 13 || i == 18 || i == 23) {
+			if c != '-' {
+				return ErrInvalidUUID
+			}
+			continue
+		}
+		nibble, ok := hexNibble(c)
+		if !ok {
+			return ErrInvalidUUID
+		}
+		word := 3 - digit/8
+		parsed[word] = parsed[word]<<4 | uint32(nibble)
+		digit++
+	}
+	*u = parsed
+	return nil
+}
+
+func hexNibble(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 0xa, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 0xa, true
+	}
+	return 0, false
+}
+
+func (u UUID) MarshalText() ([]byte, error) {
+	return u.AppendText(make([]byte, 0, 36))
+}
+
+// Bytes returns the UUID in the little-endian byte order used on air, the
+// reverse of the order NewUUID takes.
+func (u UUID) Bytes() [16]byte {
+	var b [16]byte
+	for i, word := range u {
+		binary.LittleEndian.PutUint32(b[i*4:], word)
+	}
+	return b
+}
+
+// MarshalBinary returns the 16 little-endian bytes of Bytes.
+func (u UUID) MarshalBinary() ([]byte, error) {
+	b := u.Bytes()
+	return b[:], nil
+}
+
+// UnmarshalBinary accepts 2, 4 or 16 little-endian bytes, the sizes used
+// for UUIDs in advertising data and ATT.
+func (u *UUID) UnmarshalBinary(data []byte) error {
+	switch len(data) {
+	case 2:
+		*u = New16BitUUID(binary.LittleEndian.Uint16(data))
+	case 4:
+		*u = New32BitUUID(binary.LittleEndian.Uint32(data))
+	case 16:
+		for i := range u {
+			u[i] = binary.LittleEndian.Uint32(data[i*4:])
+		}
+	default:
+		return ErrInvalidUUID
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package bluetooth
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseUUID(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"180d", "0000180d-0000-1000-8000-00805f9b34fb"},
+		{"180D", "0000180d-0000-1000-8000-00805f9b34fb"},
+		{"12345678", "12345678-0000-1000-8000-00805f9b34fb"},
+		{"6e400001-b5a3-f393-e0a9-e50e24dcca9e", "6e400001-b5a3-f393-e0a9-e50e24dcca9e"},
+		{"6E400001B5A3F393E0A9E50E24DCCA9E", "6e400001-b5a3-f393-e0a9-e50e24dcca9e"},
+	}
+	for _, tt := range tests {
+		u, err := ParseUUID(tt.in)
+		if err != nil {
+			t.Errorf("ParseUUID(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got := u.String(); got != tt.want {
+			t.Errorf("ParseUUID(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseUUIDInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"180",
+		"18 0d",
+		"123456789",
+		"6e400001-b5a3-f393-e0a9-e50e24dcca9",
+		"6e400001xb5a3-f393-e0a9-e50e24dcca9e",
+		"6e400001-b5a3-f393-e0a9-e50e24dcca9g",
+	} {
+		if _, err := ParseUUID(s); err != ErrInvalidUUID {
+			t.Errorf("ParseUUID(%q) error = %v, want %v", s, err, ErrInvalidUUID)
+		}
+	}
+}
+
+func TestShortUUID(t *testing.T) {
+	u := New16BitUUID(0x180D)
+	if !u.Is16Bit() || !u.Is32Bit() || u.Get16Bit() != 0x180D {
+		t.Errorf("New16BitUUID(0x180D): Is16Bit %v, Is32Bit %v, Get16Bit %04x", u.Is16Bit(), u.Is32Bit(), u.Get16Bit())
+	}
+
+	u = New32BitUUID(0x12345678)
+	if u.Is16Bit() || !u.Is32Bit() || u.Get32Bit() != 0x12345678 {
+		t.Errorf("New32BitUUID(0x12345678): Is16Bit %v, Is32Bit %v, Get32Bit %08x", u.Is16Bit(), u.Is32Bit(), u.Get32Bit())
+	}
+
+	u, err := ParseUUID("6e400001-b5a3-f393-e0a9-e50e24dcca9e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Is16Bit() || u.Is32Bit() {
+		t.Errorf("%s: Is16Bit %v, Is32Bit %v, want false", u, u.Is16Bit(), u.Is32Bit())
+	}
+}
+
+func TestUUIDBinary(t *testing.T) {
+	u, err := ParseUUID("6e400001-b5a3-f393-e0a9-e50e24dcca9e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x9e, 0xca, 0xdc, 0x24, 0x0e, 0xe5, 0xa9, 0xe0, 0x93, 0xf3, 0xa3, 0xb5, 0x01, 0x00, 0x40, 0x6e}
+	data, err := u.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("MarshalBinary() = % x, want % x", data, want)
+	}
+
+	tests := []struct {
+		data []byte
+		want UUID
+	}{
+		{[]byte{0x0d, 0x18}, New16BitUUID(0x180D)},
+		{[]byte{0x78, 0x56, 0x34, 0x12}, New32BitUUID(0x12345678)},
+		{want, u},
+	}
+	for _, tt := range tests {
+		var got UUID
+		if err := got.UnmarshalBinary(tt.data); err != nil {
+			t.Errorf("UnmarshalBinary(% x) error = %v", tt.data, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("UnmarshalBinary(% x) = %s, want %s", tt.data, got, tt.want)
+		}
+	}
+
+	var got UUID
+	if err := got.UnmarshalBinary([]byte{1, 2, 3}); err != ErrInvalidUUID {
+		t.Errorf("UnmarshalBinary of 3 bytes error = %v, want %v", err, ErrInvalidUUID)
+	}
+}
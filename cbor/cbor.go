@@ -0,0 +1,104 @@
+// Package cbor encodes and decodes the subset of CBOR (RFC 8949) that maps
+// onto JSON: integers, floats, byte and text strings, arrays, maps, tags,
+// booleans and null. Indefinite-length items are decoded but never
+// produced.
+package cbor
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Major types.
+const (
+	majorUint   = 0
+	majorNegInt = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorTag    = 6
+	majorSimple = 7
+)
+
+// Simple values and the additional information values used with them.
+const (
+	simpleFalse     = 20
+	simpleTrue      = 21
+	simpleNull      = 22
+	simpleUndefined = 23
+	infoFloat16     = 25
+	infoFloat32     = 26
+	infoFloat64     = 27
+	infoIndefinite  = 31
+	breakByte       = 0xff
+)
+
+var (
+	ErrUnexpectedEnd = errors.New("cbor: unexpected end of data")
+	ErrTrailingData  = errors.New("cbor: trailing data after item")
+	ErrMalformed     = errors.New("cbor: malformed item")
+	ErrTooDeep       = errors.New("cbor: nesting too deep")
+)
+
+// Tag is a tagged data item.
+type Tag struct {
+	Number  uint64
+	Content interface{}
+}
+
+// IsMap reports whether data starts with a CBOR map, which is how a
+// transport can tell CBOR commands from text.
+func IsMap(data []byte) bool {
+	return len(data) > 0 && data[0]>>5 == majorMap
+}
+
+// ToJSON converts a decoded value into one encoding/json can marshal: map
+// keys become strings, byte strings become base64url text as RFC 8949
+// section 6.1 suggests, and tags are replaced by their content.
+func ToJSON(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case []byte:
+		return base64.RawURLEncoding.EncodeToString(v), nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, elem := range v {
+			var err error
+			if out[i], err = ToJSON(elem); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			name, err := keyString(key)
+			if err != nil {
+				return nil, err
+			}
+			if out[name], err = ToJSON(elem); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case Tag:
+		return ToJSON(v.Content)
+	}
+	return v, nil
+}
+
+func keyString(key interface{}) (string, error) {
+	switch key := key.(type) {
+	case string:
+		return key, nil
+	case int64:
+		return strconv.FormatInt(key, 10), nil
+	case uint64:
+		return strconv.FormatUint(key, 10), nil
+	case bool:
+		return strconv.FormatBool(key), nil
+	}
+	return "", fmt.Errorf("cbor: map key of type %T has no JSON form", key)
+}
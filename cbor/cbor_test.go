@@ -0,0 +1,255 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+type vector struct {
+	hex   string
+	value interface{}
+}
+
+// definite holds the examples of RFC 8949 Appendix A that use preferred
+// serialization, so Marshal must produce them exactly.
+var definite = []vector{
+	{"00", int64(0)},
+	{"01", int64(1)},
+	{"0a", int64(10)},
+	{"17", int64(23)},
+	{"1818", int64(24)},
+	{"1819", int64(25)},
+	{"1864", int64(100)},
+	{"1903e8", int64(1000)},
+	{"1a000f4240", int64(1000000)},
+	{"1b000000e8d4a51000", int64(1000000000000)},
+	{"1bffffffffffffffff", uint64(18446744073709551615)},
+	{"c249010000000000000000", Tag{Number: 2, Content: []byte{1, 0, 0, 0, 0, 0, 0, 0, 0}}},
+	{"20", int64(-1)},
+	{"29", int64(-10)},
+	{"3863", int64(-100)},
+	{"3903e7", int64(-1000)},
+	{"f90000", 0.0},
+	{"f98000", math.Copysign(0, -1)},
+	{"f93c00", 1.0},
+	{"fb3ff199999999999a", 1.1},
+	{"f93e00", 1.5},
+	{"f97bff", 65504.0},
+	{"fa47c35000", 100000.0},
+	{"fa7f7fffff", 3.4028234663852886e+38},
+	{"fb7e37e43c8800759c", 1.0e+300},
+	{"f90001", 5.960464477539063e-8},
+	{"f90400", 0.00006103515625},
+	{"f9c400", -4.0},
+	{"fbc010666666666666", -4.1},
+	{"f97c00", math.Inf(1)},
+	{"f97e00", math.NaN()},
+	{"f9fc00", math.Inf(-1)},
+	{"f4", false},
+	{"f5", true},
+	{"f6", nil},
+	{"c074323031332d30332d32315432303a30343a30305a", Tag{Number: 0, Content: "2013-03-21T20:04:00Z"}},
+	{"c11a514b67b0", Tag{Number: 1, Content: int64(1363896240)}},
+	{"c1fb41d452d9ec200000", Tag{Number: 1, Content: 1363896240.5}},
+	{"d74401020304", Tag{Number: 23, Content: []byte{1, 2, 3, 4}}},
+	{"d818456449455446", Tag{Number: 24, Content: []byte("dIETF")}},
+	{"d82076687474703a2f2f7777772e6578616d706c652e636f6d", Tag{Number: 32, Content: "http://www.example.com"}},
+	{"40", []byte{}},
+	{"4401020304", []byte{1, 2, 3, 4}},
+	{"60", ""},
+	{"6161", "a"},
+	{"6449455446", "IETF"},
+	{"62225c", "\"\\"},
+	{"62c3bc", "ü"},
+	{"63e6b0b4", "水"},
+	{"64f0908591", "\U00010151"},
+	{"80", []interface{}{}},
+	{"83010203", []interface{}{int64(1), int64(2), int64(3)}},
+	{"8301820203820405", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+	{"98190102030405060708090a0b0c0d0e0f101112131415161718181819", count(25)},
+	{"a0", map[interface{}]interface{}{}},
+	{"a201020304", map[interface{}]interface{}{int64(1): int64(2), int64(3): int64(4)}},
+	{"a26161016162820203", map[interface{}]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+	{"826161a161626163", []interface{}{"a", map[interface{}]interface{}{"b": "c"}}},
+	{"a56161614161626142616361436164614461656145", map[interface{}]interface{}{"a": "A", "b": "B", "c": "C", "d": "D", "e": "E"}},
+}
+
+// decodeOnly holds the examples of RFC 8949 Appendix A that Marshal never
+// produces: undefined, wider floats than needed and indefinite lengths.
+var decodeOnly = []vector{
+	{"f7", nil},
+	{"fa7f800000", math.Inf(1)},
+	{"fa7fc00000", math.NaN()},
+	{"faff800000", math.Inf(-1)},
+	{"fb7ff0000000000000", math.Inf(1)},
+	{"fb7ff8000000000000", math.NaN()},
+	{"fbfff0000000000000", math.Inf(-1)},
+	{"5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+	{"7f657374726561646d696e67ff", "streaming"},
+	{"9fff", []interface{}{}},
+	{"9f018202039f0405ffff", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+	{"9f01820203820405ff", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+	{"83018202039f0405ff", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+	{"83019f0203ff820405", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+	{"9f0102030405060708090a0b0c0d0e0f101112131415161718181819ff", count(25)},
+	{"bf61610161629f0203ffff", map[interface{}]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+	{"826161bf61626163ff", []interface{}{"a", map[interface{}]interface{}{"b": "c"}}},
+	{"bf6346756ef563416d7421ff", map[interface{}]interface{}{"Fun": true, "Amt": int64(-2)}},
+}
+
+func count(n int) []interface{} {
+	array := make([]interface{}, n)
+	for i := range array {
+		array[i] = int64(i + 1)
+	}
+	return array
+}
+
+// same is reflect.DeepEqual, except that floats must have the same sign and
+// NaN equals NaN.
+func same(a, b interface{}) bool {
+	if fa, ok := a.(float64); ok {
+		fb, ok := b.(float64)
+		return ok && (fa == fb && math.Signbit(fa) == math.Signbit(fb) || math.IsNaN(fa) && math.IsNaN(fb))
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func TestUnmarshal(t *testing.T) {
+	for _, v := range append(definite, decodeOnly...) {
+		data, _ := hex.DecodeString(v.hex)
+		got, err := Unmarshal(data)
+		if err != nil {
+			t.Errorf("Unmarshal(%s) error = %v", v.hex, err)
+			continue
+		}
+		if !same(got, v.value) {
+			t.Errorf("Unmarshal(%s) = %#v, want %#v", v.hex, got, v.value)
+		}
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	for _, v := range definite {
+		got, err := Marshal(v.value)
+		if err != nil {
+			t.Errorf("Marshal(%#v) error = %v", v.value, err)
+			continue
+		}
+		if hex.EncodeToString(got) != v.hex {
+			t.Errorf("Marshal(%#v) = %x, want %s", v.value, got, v.hex)
+		}
+	}
+}
+
+func TestMarshalGoTypes(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		hex   string
+	}{
+		{int8(-1), "20"},
+		{uint16(1000), "1903e8"},
+		{float32(1.5), "f93e00"},
+		{map[string]interface{}{"b": 1, "a": 2}, "a2616102616201"},
+		{struct {
+			Name string `json:"name"`
+			Skip int    `json:"-"`
+		}{"x", 1}, "a1646e616d656178"},
+		{[]int{1, 2}, "820102"},
+	}
+	for _, tt := range tests {
+		got, err := Marshal(tt.value)
+		if err != nil {
+			t.Errorf("Marshal(%#v) error = %v", tt.value, err)
+			continue
+		}
+		if hex.EncodeToString(got) != tt.hex {
+			t.Errorf("Marshal(%#v) = %x, want %s", tt.value, got, tt.hex)
+		}
+	}
+}
+
+func TestUnmarshalMalformed(t *testing.T) {
+	tests := []struct {
+		hex  string
+		want error
+	}{
+		{"", ErrUnexpectedEnd},
+		{"18", ErrUnexpectedEnd},
+		{"1900", ErrUnexpectedEnd},
+		{"6261", ErrUnexpectedEnd},
+		{"830102", ErrUnexpectedEnd},
+		{"9f01", ErrUnexpectedEnd},
+		{"bf6161", ErrUnexpectedEnd},
+		{"f93c", ErrUnexpectedEnd},
+		{"9b0000000100000000", ErrUnexpectedEnd},
+		{"bb0000000100000000", ErrUnexpectedEnd},
+		{"1c", ErrMalformed},
+		{"1f", ErrMalformed},
+		{"1fff", ErrMalformed},
+		{"3fff", ErrMalformed},
+		{"dfff", ErrMalformed},
+		{"ff", ErrMalformed},
+		{"f0", ErrMalformed},
+		{"f818", ErrMalformed},
+		{"62fffe", ErrMalformed},
+		{"5f6161ff", ErrMalformed},
+		{"5f5f4100ffff", ErrMalformed},
+		{"a18001", ErrMalformed},
+		{"a1c10001", ErrMalformed},
+		{"3bffffffffffffffff", ErrMalformed},
+		{"0101", ErrTrailingData},
+		{"8001", ErrTrailingData},
+	}
+	for _, tt := range tests {
+		data, _ := hex.DecodeString(tt.hex)
+		if _, err := Unmarshal(data); !errors.Is(err, tt.want) {
+			t.Errorf("Unmarshal(%s) error = %v, want %v", tt.hex, err, tt.want)
+		}
+	}
+}
+
+func TestDepth(t *testing.T) {
+	for _, open := range []byte{0x81, 0x9f, 0xc1} {
+		data := append(bytes.Repeat([]byte{open}, maxDepth), 0)
+		if open == 0x9f {
+			data = append(data, bytes.Repeat([]byte{breakByte}, maxDepth)...)
+		}
+		if _, err := Unmarshal(data); err != nil {
+			t.Errorf("Unmarshal(%d × %#x) error = %v", maxDepth, open, err)
+		}
+
+		data = append(bytes.Repeat([]byte{open}, maxDepth+1), 0)
+		if _, err := Unmarshal(data); !errors.Is(err, ErrTooDeep) {
+			t.Errorf("Unmarshal(%d × %#x) error = %v, want %v", maxDepth+1, open, err, ErrTooDeep)
+		}
+	}
+
+	var v interface{} = int64(0)
+	for i := 0; i <= maxDepth; i++ {
+		v = []interface{}{v}
+	}
+	if _, err := Marshal(v); !errors.Is(err, ErrTooDeep) {
+		t.Errorf("Marshal(%d nested arrays) error = %v, want %v", maxDepth+1, err, ErrTooDeep)
+	}
+}
+
+func TestToJSON(t *testing.T) {
+	data, _ := hex.DecodeString("a3016161626869c243010203f5f6")
+	v, err := Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ToJSON(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"1": "a", "hi": "AQID", "true": nil}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToJSON(%#v) = %#v, want %#v", v, got, want)
+	}
+}
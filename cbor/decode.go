@@ -0,0 +1,289 @@
+package cbor
+
+import (
+	"encoding/binary"
+	"math"
+	"unicode/utf8"
+)
+
+// maxDepth bounds nesting so that hostile input cannot exhaust the stack.
+const maxDepth = 64
+
+// Unmarshal decodes a single data item. Integers become int64, or uint64
+// if they do not fit, floats become float64, text strings string, byte
+// strings []byte, arrays []interface{} and maps map[interface{}]interface{}.
+// Null and undefined become nil.
+func Unmarshal(data []byte) (interface{}, error) {
+	d := decoder{data: data}
+	v, err := d.item(0)
+	if err != nil {
+		return nil, err
+	}
+	if d.off != len(d.data) {
+		return nil, ErrTrailingData
+	}
+	return v, nil
+}
+
+type decoder struct {
+	data []byte
+	off  int
+}
+
+func (d *decoder) byte() (byte, error) {
+	if d.off >= len(d.data) {
+		return 0, ErrUnexpectedEnd
+	}
+	b := d.data[d.off]
+	d.off++
+	return b, nil
+}
+
+func (d *decoder) bytes(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.off) {
+		return nil, ErrUnexpectedEnd
+	}
+	b := d.data[d.off : d.off+int(n)]
+	d.off += int(n)
+	return b, nil
+}
+
+// argument reads the argument that follows the initial byte.
+func (d *decoder) argument(info byte) (uint64, error) {
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		b, err := d.byte()
+		return uint64(b), err
+	case info <= 27:
+		b, err := d.bytes(1 << (info - 24))
+		if err != nil {
+			return 0, err
+		}
+		switch len(b) {
+		case 2:
+			return uint64(binary.BigEndian.Uint16(b)), nil
+		case 4:
+			return uint64(binary.BigEndian.Uint32(b)), nil
+		}
+		return binary.BigEndian.Uint64(b), nil
+	}
+	return 0, ErrMalformed
+}
+
+func (d *decoder) item(depth int) (interface{}, error) {
+	if depth > maxDepth {
+		return nil, ErrTooDeep
+	}
+	initial, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+	major, info := initial>>5, initial&0x1f
+
+	if major == majorSimple {
+		return d.simple(info)
+	}
+	if info == infoIndefinite {
+		return d.indefinite(major, depth)
+	}
+	arg, err := d.argument(info)
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case majorUint:
+		if arg > math.MaxInt64 {
+			return arg, nil
+		}
+		return int64(arg), nil
+	case majorNegInt:
+		if arg > math.MaxInt64 {
+			return nil, ErrMalformed
+		}
+		return -1 - int64(arg), nil
+	case majorBytes:
+		b, err := d.bytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, b...), nil
+	case majorText:
+		b, err := d.bytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !utf8.Valid(b) {
+			return nil, ErrMalformed
+		}
+		return string(b), nil
+	case majorArray:
+		if arg > uint64(len(d.data)-d.off) {
+			return nil, ErrUnexpectedEnd
+		}
+		array := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			v, err := d.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, v)
+		}
+		return array, nil
+	case majorMap:
+		if arg > uint64(len(d.data)-d.off)/2 {
+			return nil, ErrUnexpectedEnd
+		}
+		m := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			if err := d.entry(m, depth); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case majorTag:
+		v, err := d.item(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return Tag{Number: arg, Content: v}, nil
+	}
+	return nil, ErrMalformed
+}
+
+func (d *decoder) entry(m map[interface{}]interface{}, depth int) error {
+	key, err := d.item(depth + 1)
+	if err != nil {
+		return err
+	}
+	switch key.(type) {
+	case []interface{}, map[interface{}]interface{}, []byte, Tag:
+		// Not comparable, or not usable as a Go map key.
+		return ErrMalformed
+	}
+	v, err := d.item(depth + 1)
+	if err != nil {
+		return err
+	}
+	m[key] = v
+	return nil
+}
+
+func (d *decoder) simple(info byte) (interface{}, error) {
+	switch info {
+	case simpleFalse:
+		return false, nil
+	case simpleTrue:
+		return true, nil
+	case simpleNull, simpleUndefined:
+		return nil, nil
+	case infoFloat16:
+		b, err := d.bytes(2)
+		if err != nil {
+			return nil, err
+		}
+		return float16(binary.BigEndian.Uint16(b)), nil
+	case infoFloat32:
+		b, err := d.bytes(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case infoFloat64:
+		b, err := d.bytes(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	}
+	return nil, ErrMalformed
+}
+
+func float16(h uint16) float64 {
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	var v float64
+	switch exp {
+	case 0:
+		v = math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant == 0 {
+			v = math.Inf(1)
+		} else {
+			v = math.NaN()
+		}
+	default:
+		v = math.Ldexp(mant+1024, exp-25)
+	}
+	if h&0x8000 != 0 {
+		return -v
+	}
+	return v
+}
+
+// indefinite decodes an indefinite-length string, array or map, which ends
+// with a break byte.
+func (d *decoder) indefinite(major byte, depth int) (interface{}, error) {
+	switch major {
+	case majorBytes, majorText, majorArray, majorMap:
+	default:
+		return nil, ErrMalformed
+	}
+	var chunks []byte
+	var array []interface{}
+	m := make(map[interface{}]interface{})
+	for {
+		if d.off >= len(d.data) {
+			return nil, ErrUnexpectedEnd
+		}
+		if d.data[d.off] == breakByte {
+			d.off++
+			break
+		}
+		switch major {
+		case majorBytes, majorText:
+			// Each chunk must be a definite string of the same type.
+			if d.data[d.off]>>5 != major || d.data[d.off]&0x1f == infoIndefinite {
+				return nil, ErrMalformed
+			}
+			v, err := d.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			switch v := v.(type) {
+			case []byte:
+				chunks = append(chunks, v...)
+			case string:
+				chunks = append(chunks, v...)
+			}
+		case majorArray:
+			v, err := d.item(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, v)
+		case majorMap:
+			if err := d.entry(m, depth); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	switch major {
+	case majorBytes:
+		if chunks == nil {
+			chunks = []byte{}
+		}
+		return chunks, nil
+	case majorText:
+		return string(chunks), nil
+	case majorArray:
+		if array == nil {
+			array = []interface{}{}
+		}
+		return array, nil
+	}
+	return m, nil
+}
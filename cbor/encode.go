@@ -0,0 +1,292 @@
+package cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Marshal encodes v. Besides the types Unmarshal returns it accepts other
+// integer and float types, json.Number, and through reflection slices,
+// arrays, maps, pointers and structs, whose fields are named as with
+// encoding/json. Map keys are sorted as for deterministic encoding, and
+// floats use the shortest of the three widths that keeps their value.
+func Marshal(v interface{}) ([]byte, error) {
+	return appendValue(nil, v, 0)
+}
+
+// FromJSON converts a JSON document to CBOR.
+func FromJSON(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return Marshal(v)
+}
+
+func appendHead(buf []byte, major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return append(buf, major<<5|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(buf, major<<5|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(buf, major<<5|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(buf, major<<5|26), uint32(arg))
+	}
+	return binary.BigEndian.AppendUint64(append(buf, major<<5|27), arg)
+}
+
+func appendInt(buf []byte, i int64) []byte {
+	if i < 0 {
+		return appendHead(buf, majorNegInt, uint64(-1-i))
+	}
+	return appendHead(buf, majorUint, uint64(i))
+}
+
+func appendFloat(buf []byte, f float64) []byte {
+	if f32 := float32(f); float64(f32) == f || math.IsNaN(f) {
+		if h, ok := toFloat16(f32); ok {
+			return binary.BigEndian.AppendUint16(append(buf, majorSimple<<5|infoFloat16), h)
+		}
+		return binary.BigEndian.AppendUint32(append(buf, majorSimple<<5|infoFloat32), math.Float32bits(f32))
+	}
+	return binary.BigEndian.AppendUint64(append(buf, majorSimple<<5|infoFloat64), math.Float64bits(f))
+}
+
+// toFloat16 returns the half precision form of f if it has one that keeps
+// its value exactly.
+func toFloat16(f float32) (uint16, bool) {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+	switch {
+	case exp == 0xff:
+		if mant != 0 {
+			return 0x7e00, true // canonical NaN
+		}
+		return sign | 0x7c00, true
+	case exp == 0 && mant == 0:
+		return sign, true
+	}
+	e := exp - 127
+	switch {
+	case e >= -14 && e <= 15 && mant&0x1fff == 0:
+		return sign | uint16(e+15)<<10 | uint16(mant>>13), true
+	case e >= -24 && e < -14:
+		// Subnormal half: the implicit bit becomes explicit.
+		shift := uint(-e - 14 + 13)
+		full := mant | 0x800000
+		if full&(1<<shift-1) != 0 {
+			return 0, false
+		}
+		return sign | uint16(full>>shift), true
+	}
+	return 0, false
+}
+
+func appendValue(buf []byte, v interface{}, depth int) ([]byte, error) {
+	if depth > maxDepth {
+		return nil, ErrTooDeep
+	}
+	switch v := v.(type) {
+	case nil:
+		return append(buf, majorSimple<<5|simpleNull), nil
+	case bool:
+		if v {
+			return append(buf, majorSimple<<5|simpleTrue), nil
+		}
+		return append(buf, majorSimple<<5|simpleFalse), nil
+	case int:
+		return appendInt(buf, int64(v)), nil
+	case int8:
+		return appendInt(buf, int64(v)), nil
+	case int16:
+		return appendInt(buf, int64(v)), nil
+	case int32:
+		return appendInt(buf, int64(v)), nil
+	case int64:
+		return appendInt(buf, v), nil
+	case uint:
+		return appendHead(buf, majorUint, uint64(v)), nil
+	case uint8:
+		return appendHead(buf, majorUint, uint64(v)), nil
+	case uint16:
+		return appendHead(buf, majorUint, uint64(v)), nil
+	case uint32:
+		return appendHead(buf, majorUint, uint64(v)), nil
+	case uint64:
+		return appendHead(buf, majorUint, v), nil
+	case float32:
+		return appendFloat(buf, float64(v)), nil
+	case float64:
+		return appendFloat(buf, v), nil
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 64); err == nil {
+			return appendInt(buf, i), nil
+		}
+		if u, err := strconv.ParseUint(string(v), 10, 64); err == nil {
+			return appendHead(buf, majorUint, u), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("cbor: %w", err)
+		}
+		return appendFloat(buf, f), nil
+	case string:
+		return append(appendHead(buf, majorText, uint64(len(v))), v...), nil
+	case []byte:
+		return append(appendHead(buf, majorBytes, uint64(len(v))), v...), nil
+	case Tag:
+		return appendValue(appendHead(buf, majorTag, v.Number), v.Content, depth+1)
+	case []interface{}:
+		buf = appendHead(buf, majorArray, uint64(len(v)))
+		for _, elem := range v {
+			var err error
+			if buf, err = appendValue(buf, elem, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case map[string]interface{}:
+		entries := make([]entry, 0, len(v))
+		for key, elem := range v {
+			entries = append(entries, entry{key, elem})
+		}
+		return appendMap(buf, entries, depth)
+	case map[interface{}]interface{}:
+		entries := make([]entry, 0, len(v))
+		for key, elem := range v {
+			entries = append(entries, entry{key, elem})
+		}
+		return appendMap(buf, entries, depth)
+	}
+	return appendReflect(buf, reflect.ValueOf(v), depth)
+}
+
+type entry struct {
+	key, value interface{}
+}
+
+// appendMap encodes the entries sorted by their encoded keys.
+func appendMap(buf []byte, entries []entry, depth int) ([]byte, error) {
+	type encoded struct {
+		key   []byte
+		value interface{}
+	}
+	sorted := make([]encoded, len(entries))
+	for i, e := range entries {
+		key, err := appendValue(nil, e.key, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		sorted[i] = encoded{key, e.value}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].key, sorted[j].key) < 0
+	})
+
+	buf = appendHead(buf, majorMap, uint64(len(sorted)))
+	for _, e := range sorted {
+		buf = append(buf, e.key...)
+		var err error
+		if buf, err = appendValue(buf, e.value, depth+1); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func appendReflect(buf []byte, rv reflect.Value, depth int) ([]byte, error) {
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return append(buf, majorSimple<<5|simpleNull), nil
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return append(buf, majorSimple<<5|simpleNull), nil
+		}
+		return appendValue(buf, rv.Elem().Interface(), depth+1)
+	case reflect.Bool:
+		return appendValue(buf, rv.Bool(), depth)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return appendInt(buf, rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return appendHead(buf, majorUint, rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return appendFloat(buf, rv.Float()), nil
+	case reflect.String:
+		return appendValue(buf, rv.String(), depth)
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return appendValue(buf, b, depth)
+		}
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return append(buf, majorSimple<<5|simpleNull), nil
+		}
+		buf = appendHead(buf, majorArray, uint64(rv.Len()))
+		for i := 0; i < rv.Len(); i++ {
+			var err error
+			if buf, err = appendValue(buf, rv.Index(i).Interface(), depth+1); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case reflect.Map:
+		if rv.IsNil() {
+			return append(buf, majorSimple<<5|simpleNull), nil
+		}
+		entries := make([]entry, 0, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			entries = append(entries, entry{iter.Key().Interface(), iter.Value().Interface()})
+		}
+		return appendMap(buf, entries, depth)
+	case reflect.Struct:
+		return appendStruct(buf, rv, depth)
+	}
+	return nil, fmt.Errorf("cbor: cannot encode %s", rv.Type())
+}
+
+// appendStruct encodes the exported fields as a map, honouring the json
+// tag for the name, "-" and omitempty.
+func appendStruct(buf []byte, rv reflect.Value, depth int) ([]byte, error) {
+	var entries []entry
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		if parts[0] != "" {
+			name = parts[0]
+		}
+		value := rv.Field(i)
+		omitEmpty := false
+		for _, opt := range parts[1:] {
+			omitEmpty = omitEmpty || opt == "omitempty"
+		}
+		if omitEmpty && value.IsZero() {
+			continue
+		}
+		entries = append(entries, entry{name, value.Interface()})
+	}
+	return appendMap(buf, entries, depth)
+}
@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// DefaultBaseURL is the backend used when Config.BaseURL is empty.
+const DefaultBaseURL = "http://localhost:9000"
+
+// Backend is the HTTP server commands are forwarded to.
+type Backend struct {
+	BaseURL string
+	Client  *http.Client
+	// Breaker, if set, refuses calls while the backend is failing.
+	Breaker *Breaker
+}
+
+// Get sends a GET request for path. Errors reaching the backend are
+// reported as CodeBackendUnavailable; a response with any status is not an
+// error.
+func (b *Backend) Get(ctx context.Context, path string) (*Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.BaseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return b.do(req)
+}
+
+// Post sends payload encoded as JSON to path.
+func (b *Backend) Post(ctx context.Context, path string, payload interface{}) (*Response, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.BaseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return b.do(req)
+}
+
+// Do sends a request with the given headers. A non-nil body is encoded as
+// JSON.
+func (b *Backend) Do(ctx context.Context, method, path string, headers map[string]string, body interface{}) (*Response, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, b.BaseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	return b.do(req)
+}
+
+func (b *Backend) do(req *http.Request) (*Response, error) {
+	if b.Breaker == nil {
+		return b.send(req)
+	}
+	if !b.Breaker.Allow() {
+		return nil, errBackendUnavailable
+	}
+	resp, err := b.send(req)
+	if failed(resp, err) && callerGaveUp(req.Context()) {
+		b.Breaker.Release()
+	} else {
+		b.Breaker.Done(!failed(resp, err))
+	}
+	return resp, err
+}
+
+func (b *Backend) send(req *http.Request) (*Response, error) {
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		return nil, newError(CodeBackendUnavailable, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, newError(CodeBackendUnavailable, err)
+	}
+	return &Response{
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        body,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// errBackendUnavailable is returned without calling the backend while the
+// breaker is open.
+var errBackendUnavailable = &Error{Code: CodeBackendUnavailable, Message: "backend unavailable"}
+
+// Breaker stops calls to a backend that keeps failing, so clients get an
+// answer at once instead of waiting for every call to time out. After
+// Threshold failures in a row it opens for Cooldown, then lets a single call
+// through to probe the backend.
+type Breaker struct {
+	// Threshold defaults to 5 and Cooldown to 30 s.
+	Threshold int
+	Cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// Allow reports whether a call may be made. A caller that is allowed must
+// report the outcome with Done, or call Release if the outcome says nothing
+// about the backend.
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold() {
+		return true
+	}
+	if time.Now().Before(b.openUntil) || b.probing {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// Done records the outcome of a call.
+func (b *Breaker) Done(ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold() {
+		cooldown := b.Cooldown
+		if cooldown <= 0 {
+			cooldown = 30 * time.Second
+		}
+		b.openUntil = time.Now().Add(cooldown)
+	}
+}
+
+// Release ends a call that was allowed without counting it either way, as
+// for a call canceled by its caller.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// Open reports whether calls are currently refused.
+func (b *Breaker) Open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures >= b.threshold() && (time.Now().Before(b.openUntil) || b.probing)
+}
+
+func (b *Breaker) threshold() int {
+	if b.Threshold <= 0 {
+		return 5
+	}
+	return b.Threshold
+}
+
+// failed reports whether a backend call counts against the breaker: the
+// backend could not be reached or answered that it is in trouble.
+func failed(resp *Response, err error) bool {
+	if err != nil {
+		var e *Error
+		return !errors.As(err, &e) || e.Code == CodeBackendUnavailable
+	}
+	return resp.Status >= 500
+}
+
+// callerGaveUp reports whether a call ended because its context did, which
+// says nothing about the backend unless the route timeout ran out.
+func callerGaveUp(ctx context.Context) bool {
+	return ctx.Err() != nil && context.Cause(ctx) != errRouteTimeout
+}
@@ -0,0 +1,150 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	// Each step reports an outcome, or releases the call, and checks the
+	// state afterwards.
+	type step struct {
+		wait    time.Duration // before the step
+		outcome string        // "ok", "fail" or "release"
+		allowed bool
+		open    bool
+	}
+	const cooldown = 20 * time.Millisecond
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{"below threshold", []step{
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true},
+			{outcome: "ok", allowed: true},
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true},
+		}},
+		{"opens at threshold", []step{
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true, open: true},
+			{allowed: false, open: true},
+		}},
+		{"probe closes", []step{
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true, open: true},
+			{wait: 2 * cooldown, outcome: "ok", allowed: true},
+			{outcome: "ok", allowed: true},
+		}},
+		{"probe reopens", []step{
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true, open: true},
+			{wait: 2 * cooldown, outcome: "fail", allowed: true, open: true},
+			{allowed: false, open: true},
+		}},
+		{"released probe", []step{
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true},
+			{outcome: "fail", allowed: true, open: true},
+			{wait: 2 * cooldown, outcome: "release", allowed: true},
+			{outcome: "ok", allowed: true},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Breaker{Threshold: 3, Cooldown: cooldown}
+			for i, s := range tt.steps {
+				time.Sleep(s.wait)
+				if got := b.Allow(); got != s.allowed {
+					t.Fatalf("step %d: Allow() = %v, want %v", i, got, s.allowed)
+				}
+				if s.allowed {
+					switch s.outcome {
+					case "ok":
+						b.Done(true)
+					case "fail":
+						b.Done(false)
+					case "release":
+						b.Release()
+					}
+				}
+				if got := b.Open(); got != s.open {
+					t.Fatalf("step %d: Open() = %v, want %v", i, got, s.open)
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerProbesOnce(t *testing.T) {
+	b := &Breaker{Threshold: 1, Cooldown: time.Millisecond}
+	b.Allow()
+	b.Done(false)
+	time.Sleep(5 * time.Millisecond)
+	if !b.Allow() {
+		t.Fatal("Allow() = false after the cooldown, want a probe")
+	}
+	if b.Allow() {
+		t.Error("Allow() = true while probing, want false")
+	}
+}
+
+// TestBreakerCountsBackend checks which failed calls count against the
+// breaker.
+func TestBreakerCountsBackend(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	}))
+	defer slow.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	background := func(t *testing.T) context.Context { return context.Background() }
+	canceled := func(t *testing.T) context.Context {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		return ctx
+	}
+	deadline := func(t *testing.T) context.Context {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		t.Cleanup(cancel)
+		return ctx
+	}
+	tests := []struct {
+		name   string
+		url    string
+		route  Route
+		ctx    func(t *testing.T) context.Context
+		counts bool
+	}{
+		{"server error", failing.URL, Route{Path: "/"}, background, true},
+		{"canceled", slow.URL, Route{Path: "/"}, canceled, false},
+		{"route timeout", slow.URL, Route{Path: "/", Timeout: Duration(10 * time.Millisecond)}, background, true},
+		{"caller deadline", slow.URL, Route{Path: "/", Timeout: Duration(100 * time.Millisecond)}, deadline, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Breaker{Threshold: 1}
+			req := &Request{Backend: &Backend{BaseURL: tt.url, Client: http.DefaultClient, Breaker: b}}
+			tt.route.Handler()(tt.ctx(t), req)
+			if b.Open() != tt.counts {
+				t.Errorf("breaker open = %v, want %v", b.Open(), tt.counts)
+			}
+			if !tt.counts && !b.Allow() {
+				t.Error("Allow() = false after a call that does not count")
+			}
+		})
+	}
+}
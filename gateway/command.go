@@ -0,0 +1,87 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/mikoaf/mikoafble/cbor"
+)
+
+// Keys of a CBOR command, {0: cmd, 1: {args}, 2: id}. Integer keys keep the
+// command a few bytes shorter than field names would.
+const (
+	commandKeyCmd  = 0
+	commandKeyArgs = 1
+	commandKeyID   = 2
+)
+
+var errCBORCommand = errors.New("gateway: CBOR command must be a map {0: cmd, 1: {args}, 2: id}")
+
+// parseCBORCommand decodes a CBOR command into req. The arguments keep
+// their types in req.Args and are also given in text form in req.Params.
+func parseCBORCommand(data []byte, req *Request) error {
+	v, err := cbor.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return errCBORCommand
+	}
+	cmd, ok := m[int64(commandKeyCmd)].(string)
+	if !ok {
+		return errCBORCommand
+	}
+	req.Command = cmd
+	req.Params = url.Values{"cmd": {cmd}}
+	if id, ok := m[int64(commandKeyID)].(int64); ok && id >= 0 && id <= 0xffff {
+		req.ID = uint16(id)
+	}
+
+	if raw, ok := m[int64(commandKeyArgs)]; ok {
+		args, ok := raw.(map[interface{}]interface{})
+		if !ok {
+			return errCBORCommand
+		}
+		converted, err := cbor.ToJSON(args)
+		if err != nil {
+			return err
+		}
+		req.Args = converted.(map[string]interface{})
+		for name, value := range req.Args {
+			if !reservedParams[name] {
+				req.Params.Set(name, argString(value))
+			}
+		}
+	}
+	return nil
+}
+
+// argString returns the text form of a typed argument, as it would appear
+// in a query string.
+func argString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case nil:
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,177 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// OpenQueue keeps commands of deferrable routes in the file at path while
+// the backend is unreachable and replays them in order once it is back.
+// Clients get a ticket for a queued command and poll its result with
+// "cmd=status&ticket=...".
+func (g *Gateway) OpenQueue(path string) error {
+	q, err := openQueue(path, g.config.ErrorHandler)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	g.mu.Lock()
+	g.queue = q
+	g.stopReplay = cancel
+	g.mu.Unlock()
+
+	g.Handle("status", g.handleStatus)
+	g.replaying.Add(1)
+	go func() {
+		defer g.replaying.Done()
+		g.replay(ctx)
+	}()
+	return nil
+}
+
+func (g *Gateway) closeQueue() error {
+	g.mu.Lock()
+	q, stop := g.queue, g.stopReplay
+	g.queue, g.stopReplay = nil, nil
+	g.mu.Unlock()
+	if q == nil {
+		return nil
+	}
+	// A command being replayed stores its result before the file is
+	// closed.
+	stop()
+	g.replaying.Wait()
+	return q.close()
+}
+
+// deferrable wraps the handler of a deferrable route so that the command is
+// queued when the backend cannot be reached. Once anything is queued, new
+// commands are queued behind it to keep them in order.
+func (g *Gateway) deferrable(handler Handler) Handler {
+	return func(ctx context.Context, req *Request) (*Response, error) {
+		g.mu.Lock()
+		q := g.queue
+		g.mu.Unlock()
+		if q == nil {
+			return handler(ctx, req)
+		}
+		if q.len() == 0 {
+			resp, err := handler(ctx, req)
+			if err == nil || errorCode(err) != CodeBackendUnavailable {
+				return resp, err
+			}
+		}
+
+		params := url.Values{}
+		for name, values := range req.Params {
+			if name != "id" {
+				params[name] = values
+			}
+		}
+		ticket, err := q.add(req.Command, params.Encode(), req.Args)
+		if err != nil {
+			return nil, err
+		}
+		return queuedResponse(ticket, q.len())
+	}
+}
+
+func queuedResponse(ticket string, position int) (*Response, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"state":    "queued",
+		"ticket":   ticket,
+		"position": position,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Response{Status: http.StatusAccepted, ContentType: "application/json", Body: body}, nil
+}
+
+// handleStatus answers "cmd=status&ticket=..." with the position of a
+// queued command or the reply it got.
+func (g *Gateway) handleStatus(ctx context.Context, req *Request) (*Response, error) {
+	g.mu.Lock()
+	q := g.queue
+	g.mu.Unlock()
+	if q == nil {
+		return nil, ErrInvalidCommand
+	}
+
+	ticket := req.Params.Get("ticket")
+	position, result, ok := q.status(ticket)
+	if !ok {
+		return nil, &Error{Code: CodeBadRequest, Message: "unknown ticket"}
+	}
+	if position > 0 {
+		return queuedResponse(ticket, position)
+	}
+	env, err := UnmarshalEnvelope(result)
+	if err != nil {
+		return nil, err
+	}
+	if env.Code != CodeOK && env.Code != CodeBackendError {
+		return nil, &Error{Code: env.Code, Message: env.Message}
+	}
+	return &Response{Status: env.Status, ContentType: env.ContentType, Body: env.Body}, nil
+}
+
+// replay runs queued commands in order every ReplayInterval until the queue
+// is empty or the backend fails again.
+func (g *Gateway) replay(ctx context.Context) {
+	ticker := time.NewTicker(g.config.ReplayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		g.mu.Lock()
+		q := g.queue
+		g.mu.Unlock()
+		for q != nil && ctx.Err() == nil && g.replayNext(ctx, q) {
+		}
+	}
+}
+
+// replayNext runs the oldest queued command and reports whether the next
+// one should be tried.
+func (g *Gateway) replayNext(ctx context.Context, q *queue) bool {
+	r, ok := q.next()
+	if !ok {
+		return false
+	}
+	g.mu.Lock()
+	handler := g.deferred[r.Command]
+	g.mu.Unlock()
+
+	params, _ := url.ParseQuery(r.Params)
+	req := &Request{Command: r.Command, Params: params, Args: r.Args, Backend: g.backend}
+	var resp *Response
+	var err error
+	if handler == nil {
+		// The route was removed from the table while the command waited.
+		err = ErrInvalidCommand
+	} else {
+		ctx, cancel := context.WithTimeout(ctx, g.config.Timeout)
+		resp, err = handler(ctx, req)
+		cancel()
+		if err != nil && errorCode(err) == CodeBackendUnavailable {
+			return false
+		}
+	}
+
+	result, err := envelopeFor(req, resp, err).Marshal(EnvelopeBinary)
+	if err == nil {
+		err = q.done(r.Ticket, result)
+	}
+	if err != nil {
+		g.config.ErrorHandler(err)
+		return false
+	}
+	return true
+}
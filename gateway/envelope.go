@@ -0,0 +1,382 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mikoaf/mikoafble/cbor"
+)
+
+// ErrorCode tells a client why a command failed without having to parse the
+// message.
+type ErrorCode uint8
+
+const (
+	CodeOK ErrorCode = iota
+	// CodeInvalidCommand means no handler is registered for the command.
+	CodeInvalidCommand
+	// CodeBadRequest means the parameters were refused by the gateway.
+	CodeBadRequest
+	// CodeBackendError means the backend answered with a non-2xx status.
+	CodeBackendError
+	// CodeBackendUnavailable means the backend could not be reached.
+	CodeBackendUnavailable
+	// CodeTimeout means the command did not finish in time.
+	CodeTimeout
+	// CodeInternal is any other failure in the gateway.
+	CodeInternal
+	// CodeBusy means the gateway has too many commands queued.
+	CodeBusy
+)
+
+func (c ErrorCode) String() string {
+	switch c {
+	case CodeOK:
+		return "ok"
+	case CodeInvalidCommand:
+		return "invalid command"
+	case CodeBadRequest:
+		return "bad request"
+	case CodeBackendError:
+		return "backend error"
+	case CodeBackendUnavailable:
+		return "backend unavailable"
+	case CodeTimeout:
+		return "timeout"
+	case CodeInternal:
+		return "internal error"
+	case CodeBusy:
+		return "busy"
+	}
+	return fmt.Sprintf("ErrorCode(%d)", uint8(c))
+}
+
+// Error is an error with the code reported to the client. Handlers return
+// it to pick the code; other errors are reported as CodeInternal.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	err     error
+}
+
+func (e *Error) Error() string {
+	return "gateway: " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func newError(code ErrorCode, err error) *Error {
+	return &Error{Code: code, Message: strings.TrimPrefix(err.Error(), "gateway: "), err: err}
+}
+
+// ErrInvalidCommand is returned for commands without a handler.
+var ErrInvalidCommand = &Error{Code: CodeInvalidCommand, Message: "invalid cmd"}
+
+// errorCode returns the code to report for err.
+func errorCode(err error) ErrorCode {
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		return e.Code
+	case errors.Is(err, context.DeadlineExceeded):
+		return CodeTimeout
+	}
+	return CodeInternal
+}
+
+// Response is the result of a handler.
+type Response struct {
+	// Status is the HTTP status of the backend, or 0 if no request was
+	// made.
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+// EnvelopeMode selects how envelopes are encoded.
+type EnvelopeMode int
+
+const (
+	// EnvelopeBinary is a compact header followed by the raw body.
+	EnvelopeBinary EnvelopeMode = iota
+	// EnvelopeJSON is a JSON object, for clients that would rather not
+	// parse binary.
+	EnvelopeJSON
+	// EnvelopeCBOR is a CBOR map, used for clients that send CBOR
+	// commands.
+	EnvelopeCBOR
+)
+
+// Envelope wraps every reply sent to a client. Clients may pass "id" with a
+// command to match replies to requests.
+//
+// Session identifies the client the reply is for. The envelope a client
+// reads from the response characteristic carries its session and, as the
+// body, the key its replies are sealed with.
+//
+// The binary encoding is, with integers big endian:
+//
+//	version       uint8   2
+//	request ID    uint16
+//	session       uint32
+//	status        uint16
+//	error code    uint8
+//	content type  uint8   see the contentTypes table, 0xff for other
+//	[length uint8, content type]  only for 0xff
+//	length        uint8
+//	error message
+//	body          to the end
+//
+// The JSON encoding starts with '{'. The CBOR encoding is a map with the
+// integer keys 0 to 5 for the request ID, status, content type, error code,
+// message and body, and 6 for the session; a JSON body is embedded as CBOR
+// data rather than as text.
+type Envelope struct {
+	RequestID   uint16    `json:"id"`
+	Session     uint32    `json:"session,omitempty"`
+	Status      int       `json:"status"`
+	ContentType string    `json:"type,omitempty"`
+	Code        ErrorCode `json:"code"`
+	Message     string    `json:"error,omitempty"`
+	Body        []byte    `json:"-"`
+}
+
+const (
+	envelopeVersion    = 2
+	otherContentType   = 0xff
+	envelopeHeaderSize = 12
+)
+
+// contentTypes are the content types with a one byte code.
+var contentTypes = []string{
+	0: "",
+	1: "text/plain",
+	2: "application/json",
+	3: "application/octet-stream",
+	4: "application/cbor",
+}
+
+var ErrMalformedEnvelope = errors.New("gateway: malformed envelope")
+
+// Marshal encodes the envelope.
+func (e Envelope) Marshal(mode EnvelopeMode) ([]byte, error) {
+	switch mode {
+	case EnvelopeJSON:
+		return e.marshalJSON()
+	case EnvelopeCBOR:
+		return e.marshalCBOR()
+	}
+
+	contentType := strings.TrimSpace(strings.SplitN(e.ContentType, ";", 2)[0])
+	message := e.Message
+	if len(message) > 0xff {
+		message = message[:0xff]
+		for !utf8.ValidString(message) {
+			message = message[:len(message)-1]
+		}
+	}
+	if e.Status < 0 || e.Status > 0xffff {
+		return nil, fmt.Errorf("gateway: envelope status %d out of range", e.Status)
+	}
+
+	buf := make([]byte, 0, envelopeHeaderSize+len(message)+len(e.Body))
+	buf = append(buf, envelopeVersion)
+	buf = binary.BigEndian.AppendUint16(buf, e.RequestID)
+	buf = binary.BigEndian.AppendUint32(buf, e.Session)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(e.Status))
+	buf = append(buf, byte(e.Code))
+	code := otherContentType
+	for i, known := range contentTypes {
+		if strings.EqualFold(known, contentType) {
+			code = i
+			break
+		}
+	}
+	buf = append(buf, byte(code))
+	if code == otherContentType {
+		if len(contentType) > 0xff {
+			return nil, fmt.Errorf("gateway: content type %q too long", contentType)
+		}
+		buf = append(buf, byte(len(contentType)))
+		buf = append(buf, contentType...)
+	}
+	buf = append(buf, byte(len(message)))
+	buf = append(buf, message...)
+	return append(buf, e.Body...), nil
+}
+
+// marshalJSON carries JSON bodies as they are and other bodies as a string,
+// or base64 if they are not valid UTF-8.
+func (e Envelope) marshalJSON() ([]byte, error) {
+	type envelope Envelope
+	v := struct {
+		envelope
+		JSON   json.RawMessage `json:"body,omitempty"`
+		Text   string          `json:"text,omitempty"`
+		Binary []byte          `json:"data,omitempty"`
+	}{envelope: envelope(e)}
+	switch {
+	case len(e.Body) == 0:
+	case strings.HasPrefix(e.ContentType, "application/json") && json.Valid(e.Body):
+		v.JSON = e.Body
+	case utf8.Valid(e.Body):
+		v.Text = string(e.Body)
+	default:
+		v.Binary = e.Body
+	}
+	return json.Marshal(v)
+}
+
+// Keys of the CBOR encoding.
+const (
+	envelopeKeyID = iota
+	envelopeKeyStatus
+	envelopeKeyType
+	envelopeKeyCode
+	envelopeKeyMessage
+	envelopeKeyBody
+	envelopeKeySession
+)
+
+func (e Envelope) marshalCBOR() ([]byte, error) {
+	m := map[interface{}]interface{}{
+		envelopeKeyID:     e.RequestID,
+		envelopeKeyStatus: e.Status,
+		envelopeKeyCode:   uint8(e.Code),
+	}
+	if e.Session != 0 {
+		m[envelopeKeySession] = e.Session
+	}
+	if e.ContentType != "" {
+		m[envelopeKeyType] = e.ContentType
+	}
+	if e.Message != "" {
+		m[envelopeKeyMessage] = e.Message
+	}
+	if len(e.Body) != 0 {
+		m[envelopeKeyBody] = e.Body
+		if strings.HasPrefix(e.ContentType, "application/json") {
+			dec := json.NewDecoder(bytes.NewReader(e.Body))
+			dec.UseNumber()
+			var v interface{}
+			if dec.Decode(&v) == nil {
+				m[envelopeKeyBody] = v
+			}
+		} else if utf8.Valid(e.Body) {
+			m[envelopeKeyBody] = string(e.Body)
+		}
+	}
+	return cbor.Marshal(m)
+}
+
+func unmarshalCBOREnvelope(data []byte) (Envelope, error) {
+	v, err := cbor.Unmarshal(data)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("%w: %v", ErrMalformedEnvelope, err)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return Envelope{}, ErrMalformedEnvelope
+	}
+	var e Envelope
+	id, _ := m[int64(envelopeKeyID)].(int64)
+	status, _ := m[int64(envelopeKeyStatus)].(int64)
+	code, _ := m[int64(envelopeKeyCode)].(int64)
+	session, _ := m[int64(envelopeKeySession)].(int64)
+	e.RequestID = uint16(id)
+	e.Session = uint32(session)
+	e.Status = int(status)
+	e.Code = ErrorCode(code)
+	e.ContentType, _ = m[int64(envelopeKeyType)].(string)
+	e.Message, _ = m[int64(envelopeKeyMessage)].(string)
+	switch body := m[int64(envelopeKeyBody)].(type) {
+	case nil:
+	case []byte:
+		e.Body = body
+	case string:
+		e.Body = []byte(body)
+	default:
+		converted, err := cbor.ToJSON(body)
+		if err == nil {
+			e.Body, err = json.Marshal(converted)
+		}
+		if err != nil {
+			return Envelope{}, fmt.Errorf("%w: %v", ErrMalformedEnvelope, err)
+		}
+	}
+	return e, nil
+}
+
+// UnmarshalEnvelope decodes an envelope in any of the encodings.
+func UnmarshalEnvelope(data []byte) (Envelope, error) {
+	if len(data) > 0 && data[0] == '{' {
+		return unmarshalJSONEnvelope(data)
+	}
+	if cbor.IsMap(data) {
+		return unmarshalCBOREnvelope(data)
+	}
+	if len(data) < envelopeHeaderSize || data[0] != envelopeVersion {
+		return Envelope{}, ErrMalformedEnvelope
+	}
+	e := Envelope{
+		RequestID: binary.BigEndian.Uint16(data[1:]),
+		Session:   binary.BigEndian.Uint32(data[3:]),
+		Status:    int(binary.BigEndian.Uint16(data[7:])),
+		Code:      ErrorCode(data[9]),
+	}
+	code := int(data[10])
+	rest := data[11:]
+	switch {
+	case code == otherContentType:
+		n := int(rest[0])
+		if len(rest) < 1+n+1 {
+			return Envelope{}, ErrMalformedEnvelope
+		}
+		e.ContentType = string(rest[1 : 1+n])
+		rest = rest[1+n:]
+	case code < len(contentTypes):
+		e.ContentType = contentTypes[code]
+	default:
+		return Envelope{}, ErrMalformedEnvelope
+	}
+	n := int(rest[0])
+	if len(rest) < 1+n {
+		return Envelope{}, ErrMalformedEnvelope
+	}
+	e.Message = string(rest[1 : 1+n])
+	if body := rest[1+n:]; len(body) != 0 {
+		e.Body = body
+	}
+	return e, nil
+}
+
+func unmarshalJSONEnvelope(data []byte) (Envelope, error) {
+	type envelope Envelope
+	var v struct {
+		envelope
+		JSON   json.RawMessage `json:"body"`
+		Text   string          `json:"text"`
+		Binary []byte          `json:"data"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return Envelope{}, fmt.Errorf("%w: %v", ErrMalformedEnvelope, err)
+	}
+	e := Envelope(v.envelope)
+	switch {
+	case len(v.JSON) != 0:
+		e.Body = v.JSON
+	case v.Text != "":
+		e.Body = []byte(v.Text)
+	case len(v.Binary) != 0:
+		e.Body = v.Binary
+	}
+	return e, nil
+}
@@ -0,0 +1,99 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEnvelopeRoundTrip(t *testing.T) {
+	envelopes := []Envelope{
+		{},
+		{RequestID: 7, Session: 0xdeadbeef, Status: 200, ContentType: "application/json", Body: []byte(`{"name":"Ann","age":30}`)},
+		{RequestID: 1, Status: 200, ContentType: "text/plain", Body: []byte("hello")},
+		{RequestID: 2, Status: 200, ContentType: "image/png", Body: []byte{0x89, 'P', 'N', 'G', 0xff}},
+		{RequestID: 3, Status: 503, Code: CodeBackendError, Message: "Service Unavailable"},
+		{RequestID: 4, Code: CodeTimeout, Message: "timeout"},
+	}
+	for _, mode := range []EnvelopeMode{EnvelopeBinary, EnvelopeJSON, EnvelopeCBOR} {
+		for _, want := range envelopes {
+			data, err := want.Marshal(mode)
+			if err != nil {
+				t.Fatalf("mode %d: Marshal(%+v) error = %v", mode, want, err)
+			}
+			got, err := UnmarshalEnvelope(data)
+			if err != nil {
+				t.Fatalf("mode %d: UnmarshalEnvelope(% x) error = %v", mode, data, err)
+			}
+			if mode != EnvelopeBinary && strings.HasPrefix(want.ContentType, "application/json") {
+				// JSON bodies are re-encoded; compare them as values.
+				if !jsonEqual(t, got.Body, want.Body) {
+					t.Errorf("mode %d: body = %s, want %s", mode, got.Body, want.Body)
+				}
+				got.Body, want.Body = nil, nil
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("mode %d: got %+v, want %+v", mode, got, want)
+			}
+		}
+	}
+}
+
+func TestEnvelopeBinaryLayout(t *testing.T) {
+	env := Envelope{RequestID: 0x0102, Session: 0x03040506, Status: 404, Code: CodeBackendError, ContentType: "text/plain", Message: "Not Found", Body: []byte("x")}
+	data, err := env.Marshal(EnvelopeBinary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{envelopeVersion, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x01, 0x94, byte(CodeBackendError), 1, 9}
+	want = append(want, "Not Foundx"...)
+	if !bytes.Equal(data, want) {
+		t.Errorf("Marshal() = % x, want % x", data, want)
+	}
+}
+
+func TestEnvelopeLongMessage(t *testing.T) {
+	env := Envelope{Code: CodeInternal, Message: strings.Repeat("é", 200)}
+	data, err := env.Marshal(EnvelopeBinary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UnmarshalEnvelope(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Message != strings.Repeat("é", 127) {
+		t.Errorf("message cut to %d bytes, want 254 bytes of whole runes", len(got.Message))
+	}
+}
+
+func TestUnmarshalEnvelopeMalformed(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{envelopeVersion},
+		{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, // the layout without a session
+		{envelopeVersion, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 5, 'a'},
+		{envelopeVersion, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 'a'},
+		{envelopeVersion, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x40, 0},
+		[]byte(`{"id":`),
+		{0xa1, 0x00},
+	} {
+		if _, err := UnmarshalEnvelope(data); err == nil {
+			t.Errorf("UnmarshalEnvelope(% x) succeeded", data)
+		}
+	}
+}
+
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		t.Fatalf("invalid JSON %q: %v", a, err)
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		t.Fatalf("invalid JSON %q: %v", b, err)
+	}
+	return reflect.DeepEqual(va, vb)
+}
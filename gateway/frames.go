@@ -0,0 +1,120 @@
+package gateway
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+	"github.com/mikoaf/mikoafble/gateway/framing"
+)
+
+// handleFrames collects framed commands. Acks for a reply being sent are
+// passed on to the sender; complete commands are queued for a worker.
+func (g *Gateway) handleFrames(client bluetooth.Connection, offset int, value []byte) {
+	s := g.session(client)
+	s.mu.Lock()
+	frames := s.buffer.Write(offset, value)
+	s.mu.Unlock()
+
+	for _, frame := range frames {
+		h, _, err := framing.Parse(frame)
+		if err != nil {
+			g.config.ErrorHandler(fmt.Errorf("gateway: %w", err))
+			continue
+		}
+		if h.IsAck() {
+			g.ack(h.MessageID, h.Index)
+			continue
+		}
+		msg, _, err := s.reassembler.Add(frame)
+		if err != nil {
+			g.config.ErrorHandler(fmt.Errorf("gateway: %w", err))
+			continue
+		}
+		if msg != nil {
+			g.submit(client, msg)
+		}
+	}
+}
+
+func (g *Gateway) ack(id uint16, received uint16) {
+	g.mu.Lock()
+	ch := g.acks[id]
+	g.mu.Unlock()
+	if ch == nil {
+		return
+	}
+	// Only the latest count matters.
+	select {
+	case <-ch:
+	default:
+	}
+	ch <- received
+}
+
+// frameMTU returns the MTU negotiated with the client of s if BlueZ reported
+// one. Other clients may have a smaller one, but they cannot open the reply
+// anyway.
+func (s *session) frameMTU(fallback int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.mtu != 0 {
+		return s.mtu
+	}
+	return fallback
+}
+
+// deliver seals a reply for the client of s and notifies it, framed to the
+// client's MTU if framing is enabled.
+func (g *Gateway) deliver(s *session, reply []byte) error {
+	return g.reply(s.seal(reply), s.frameMTU(g.config.MTU))
+}
+
+// reply notifies a reply, framed for mtu if framing is enabled.
+func (g *Gateway) reply(resp []byte, mtu int) error {
+	if !g.config.Framing {
+		return g.notify(resp)
+	}
+
+	g.sendMu.Lock()
+	defer g.sendMu.Unlock()
+
+	id := uint16(g.messageID.Add(1))
+	frames, err := framing.Split(id, resp, mtu)
+	if err != nil {
+		return err
+	}
+
+	var acks chan uint16
+	if g.config.Window > 0 && len(frames) > g.config.Window {
+		acks = make(chan uint16, 1)
+		g.mu.Lock()
+		g.acks[id] = acks
+		g.mu.Unlock()
+		defer func() {
+			g.mu.Lock()
+			delete(g.acks, id)
+			g.mu.Unlock()
+		}()
+	}
+
+	acked := 0
+	for i, frame := range frames {
+		// Wait until the client has caught up to within one window.
+		for acks != nil && i-acked >= g.config.Window {
+			select {
+			case n := <-acks:
+				acked = int(n)
+			case <-time.After(g.config.AckTimeout):
+				return fmt.Errorf("gateway: reply %d: no ack after frame %d", id, acked)
+			}
+		}
+		if err := g.notify(frame); err != nil {
+			return err
+		}
+		if i < len(frames)-1 {
+			time.Sleep(g.config.FrameInterval)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/mikoaf/mikoafble/gateway/framing"
+)
+
+func TestDeliverFramesPerClientMTU(t *testing.T) {
+	g := New(nil, Config{Framing: true, FrameInterval: 1})
+	defer g.Stop(context.Background())
+	large, small := g.session(1), g.session(2)
+	large.trackMTU(517)
+
+	reply := bytes.Repeat([]byte("0123456789"), 200)
+	for _, tt := range []struct {
+		s   *session
+		mtu int
+	}{
+		{large, 517},
+		{small, framing.MinMTU},
+	} {
+		var frames [][]byte
+		g.notify = func(value []byte) error {
+			frames = append(frames, value)
+			return nil
+		}
+		if err := g.deliver(tt.s, reply); err != nil {
+			t.Fatal(err)
+		}
+
+		var r framing.Reassembler
+		var msg []byte
+		for _, frame := range frames {
+			if len(frame) > tt.mtu-3 {
+				t.Errorf("MTU %d: frame of %d bytes", tt.mtu, len(frame))
+			}
+			got, _, err := r.Add(frame)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != nil {
+				msg = got
+			}
+		}
+		opened, err := OpenReply(tt.s.token, tt.s.key[:], msg)
+		if err != nil || !bytes.Equal(opened, reply) {
+			t.Errorf("MTU %d: reply reassembled to %d bytes, %v", tt.mtu, len(opened), err)
+		}
+		if size := framing.PayloadSize(tt.mtu); len(frames) != (len(msg)+size-1)/size {
+			t.Errorf("MTU %d: %d frames for %d bytes", tt.mtu, len(frames), len(msg))
+		}
+	}
+}
@@ -0,0 +1,293 @@
+// Package framing splits messages into frames that fit a single ATT packet
+// and puts them back together on the other side.
+//
+// Every frame starts with a 12 byte header, all fields big endian:
+//
+//	message ID  uint16
+//	index       uint16  position of this frame in the message
+//	total       uint16  number of frames in the message, 0 for an ack
+//	length      uint16  payload bytes following the header
+//	crc         uint32  CRC-32 (IEEE) of the complete message
+//
+// An ack frame has no payload. Its index is the number of frames of the
+// message the receiver has seen so far, which lets a sender limit how many
+// frames are in flight.
+package framing
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sync"
+	"time"
+)
+
+const (
+	// HeaderSize is the size of the frame header.
+	HeaderSize = 12
+	// MinMTU is the ATT MTU every LE link supports.
+	MinMTU = 23
+	// attOverhead is the opcode and handle of a notification or write.
+	attOverhead = 3
+)
+
+// Defaults of the Reassembler limits.
+const (
+	DefaultTimeout     = 30 * time.Second
+	DefaultMaxFrames   = 1024
+	DefaultMaxMessages = 16
+	DefaultMaxBytes    = 64 << 10
+)
+
+var (
+	ErrShortFrame = errors.New("framing: frame shorter than its header")
+	ErrBadIndex   = errors.New("framing: frame index out of range")
+	ErrChecksum   = errors.New("framing: checksum mismatch")
+	ErrTooLarge   = errors.New("framing: message needs more than 65535 frames")
+	ErrTooMany    = errors.New("framing: message has more frames than allowed")
+	ErrBufferFull = errors.New("framing: too many bytes waiting for reassembly")
+)
+
+// Header is the decoded frame header.
+type Header struct {
+	MessageID uint16
+	Index     uint16
+	Total     uint16
+	Length    uint16
+	CRC       uint32
+}
+
+// IsAck reports whether the frame acknowledges frames of a message.
+func (h Header) IsAck() bool {
+	return h.Total == 0
+}
+
+func (h Header) append(buf []byte) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, h.MessageID)
+	buf = binary.BigEndian.AppendUint16(buf, h.Index)
+	buf = binary.BigEndian.AppendUint16(buf, h.Total)
+	buf = binary.BigEndian.AppendUint16(buf, h.Length)
+	return binary.BigEndian.AppendUint32(buf, h.CRC)
+}
+
+// Parse splits a frame into its header and payload. Trailing bytes beyond
+// the length in the header are ignored.
+func Parse(frame []byte) (Header, []byte, error) {
+	if len(frame) < HeaderSize {
+		return Header{}, nil, ErrShortFrame
+	}
+	h := Header{
+		MessageID: binary.BigEndian.Uint16(frame),
+		Index:     binary.BigEndian.Uint16(frame[2:]),
+		Total:     binary.BigEndian.Uint16(frame[4:]),
+		Length:    binary.BigEndian.Uint16(frame[6:]),
+		CRC:       binary.BigEndian.Uint32(frame[8:]),
+	}
+	if len(frame) < HeaderSize+int(h.Length) {
+		return Header{}, nil, ErrShortFrame
+	}
+	if !h.IsAck() && h.Index >= h.Total {
+		return Header{}, nil, ErrBadIndex
+	}
+	return h, frame[HeaderSize : HeaderSize+int(h.Length)], nil
+}
+
+// PayloadSize returns how many message bytes fit in one frame for the given
+// ATT MTU.
+func PayloadSize(mtu int) int {
+	if mtu < MinMTU {
+		mtu = MinMTU
+	}
+	return mtu - attOverhead - HeaderSize
+}
+
+// Split cuts msg into frames that each fit one notification or write
+// without response at the given ATT MTU.
+func Split(id uint16, msg []byte, mtu int) ([][]byte, error) {
+	size := PayloadSize(mtu)
+	total := (len(msg) + size - 1) / size
+	if total == 0 {
+		total = 1
+	}
+	if total > 0xffff {
+		return nil, ErrTooLarge
+	}
+	crc := crc32.ChecksumIEEE(msg)
+	frames := make([][]byte, 0, total)
+	for i := 0; i < total; i++ {
+		chunk := msg[i*size:]
+		if len(chunk) > size {
+			chunk = chunk[:size]
+		}
+		h := Header{MessageID: id, Index: uint16(i), Total: uint16(total), Length: uint16(len(chunk)), CRC: crc}
+		frame := h.append(make([]byte, 0, HeaderSize+len(chunk)))
+		frames = append(frames, append(frame, chunk...))
+	}
+	return frames, nil
+}
+
+// Ack returns an ack frame saying that received frames of message id have
+// arrived.
+func Ack(id uint16, received uint16) []byte {
+	return Header{MessageID: id, Index: received}.append(nil)
+}
+
+type partial struct {
+	header  Header
+	chunks  map[uint16][]byte
+	size    int // payload bytes in chunks
+	updated time.Time
+}
+
+// Reassembler collects frames until a message is complete. Frames of
+// different messages may be interleaved and may arrive out of order. It is
+// safe for concurrent use.
+//
+// The sender picks the message IDs and frame counts, so the limits below
+// bound the memory it can hold on to. Zero fields take the defaults.
+type Reassembler struct {
+	// Timeout drops messages that have not seen a frame for this long.
+	Timeout time.Duration
+	// MaxFrames is the largest number of frames a message may have.
+	MaxFrames int
+	// MaxMessages is the number of incomplete messages kept at once. When
+	// a new message would exceed it, the one idle the longest is dropped.
+	MaxMessages int
+	// MaxBytes limits the payload of all incomplete messages together.
+	MaxBytes int
+
+	mu       sync.Mutex
+	messages map[uint16]*partial
+	size     int // payload bytes in messages
+}
+
+func orDefault[T time.Duration | int](v, def T) T {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+// Add feeds one frame to the reassembler. It returns the message once its
+// last frame has arrived, and received is the number of frames of the
+// message seen so far, which can be sent back with Ack. Ack frames are not
+// accepted here; check Header.IsAck first.
+func (r *Reassembler) Add(frame []byte) (msg []byte, received uint16, err error) {
+	h, payload, err := Parse(frame)
+	if err != nil {
+		return nil, 0, err
+	}
+	if h.IsAck() {
+		return nil, 0, ErrBadIndex
+	}
+	if int(h.Total) > orDefault(r.MaxFrames, DefaultMaxFrames) {
+		return nil, 0, ErrTooMany
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	r.expire(now)
+	if r.messages == nil {
+		r.messages = make(map[uint16]*partial)
+	}
+
+	p := r.messages[h.MessageID]
+	if p == nil || p.header.Total != h.Total || p.header.CRC != h.CRC {
+		// A new message reusing the ID replaces an abandoned one.
+		if p != nil {
+			r.drop(h.MessageID)
+		}
+		if h.Total > 1 && len(r.messages) >= orDefault(r.MaxMessages, DefaultMaxMessages) {
+			r.drop(r.oldest())
+		}
+		p = &partial{header: h, chunks: make(map[uint16][]byte)}
+		r.messages[h.MessageID] = p
+	}
+	p.updated = now
+	if _, ok := p.chunks[h.Index]; !ok {
+		if r.size+len(payload) > orDefault(r.MaxBytes, DefaultMaxBytes) {
+			r.drop(h.MessageID)
+			return nil, 0, ErrBufferFull
+		}
+		p.chunks[h.Index] = append([]byte{}, payload...)
+		p.size += len(payload)
+		r.size += len(payload)
+	}
+	received = uint16(len(p.chunks))
+	if received < h.Total {
+		return nil, received, nil
+	}
+
+	r.drop(h.MessageID)
+	msg = make([]byte, 0, p.size)
+	for i := uint16(0); i < h.Total; i++ {
+		msg = append(msg, p.chunks[i]...)
+	}
+	if crc32.ChecksumIEEE(msg) != h.CRC {
+		return nil, received, ErrChecksum
+	}
+	return msg, received, nil
+}
+
+func (r *Reassembler) drop(id uint16) {
+	if p, ok := r.messages[id]; ok {
+		r.size -= p.size
+		delete(r.messages, id)
+	}
+}
+
+// oldest returns the ID of the message that has been idle the longest.
+func (r *Reassembler) oldest() uint16 {
+	var oldestID uint16
+	var oldest *partial
+	for id, p := range r.messages {
+		if oldest == nil || p.updated.Before(oldest.updated) {
+			oldestID, oldest = id, p
+		}
+	}
+	return oldestID
+}
+
+func (r *Reassembler) expire(now time.Time) {
+	timeout := orDefault(r.Timeout, DefaultTimeout)
+	for id, p := range r.messages {
+		if now.Sub(p.updated) > timeout {
+			r.drop(id)
+		}
+	}
+}
+
+// WriteBuffer joins the pieces of a long write, which arrive with the
+// offset of each piece, and returns the frames they contain. A write at
+// offset 0 starts over.
+type WriteBuffer struct {
+	buf  []byte
+	used int // bytes of buf already returned as frames
+}
+
+// Write adds a piece of a write and returns the frames that are complete.
+func (w *WriteBuffer) Write(offset int, value []byte) [][]byte {
+	if offset == 0 {
+		w.buf = w.buf[:0]
+		w.used = 0
+	}
+	if offset > len(w.buf) || offset < w.used {
+		// A piece went missing or was sent again; drop what we have.
+		w.buf = w.buf[:0]
+		w.used = 0
+		return nil
+	}
+	w.buf = append(w.buf[:offset], value...)
+
+	var frames [][]byte
+	for rest := w.buf[w.used:]; len(rest) >= HeaderSize; rest = w.buf[w.used:] {
+		size := HeaderSize + int(binary.BigEndian.Uint16(rest[6:]))
+		if len(rest) < size {
+			break
+		}
+		frames = append(frames, append([]byte{}, rest[:size]...))
+		w.used += size
+	}
+	return frames
+}
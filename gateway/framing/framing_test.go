@@ -0,0 +1,168 @@
+package framing
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestSplitReassemble(t *testing.T) {
+	for _, size := range []int{0, 1, 7, 8, 9, 100, 5000} {
+		msg := make([]byte, size)
+		rand.New(rand.NewSource(int64(size))).Read(msg)
+
+		frames, err := Split(7, msg, MinMTU)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, frame := range frames {
+			if len(frame) > MinMTU-attOverhead {
+				t.Fatalf("%d bytes: frame of %d bytes does not fit the MTU", size, len(frame))
+			}
+		}
+
+		// Out of order and with a duplicate.
+		rand.New(rand.NewSource(1)).Shuffle(len(frames), func(i, j int) { frames[i], frames[j] = frames[j], frames[i] })
+		total := len(frames)
+		if total > 1 {
+			frames = append(frames[:1], frames...)
+		}
+
+		var r Reassembler
+		var got []byte
+		for i, frame := range frames {
+			out, received, err := r.Add(frame)
+			if err != nil {
+				t.Fatalf("%d bytes: Add() error = %v", size, err)
+			}
+			if out != nil {
+				if i != len(frames)-1 {
+					t.Fatalf("%d bytes: message complete after %d of %d frames", size, i+1, len(frames))
+				}
+				if int(received) != total {
+					t.Errorf("%d bytes: received = %d, want %d", size, received, total)
+				}
+				got = out
+			}
+		}
+		if !bytes.Equal(got, msg) {
+			t.Errorf("%d bytes: reassembled message differs", size)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	if _, _, err := Parse(make([]byte, HeaderSize-1)); err != ErrShortFrame {
+		t.Errorf("short header: error = %v, want %v", err, ErrShortFrame)
+	}
+
+	frame := Header{MessageID: 1, Index: 0, Total: 1, Length: 4}.append(nil)
+	if _, _, err := Parse(append(frame, 1, 2)); err != ErrShortFrame {
+		t.Errorf("short payload: error = %v, want %v", err, ErrShortFrame)
+	}
+
+	frame = Header{MessageID: 1, Index: 2, Total: 2}.append(nil)
+	if _, _, err := Parse(frame); err != ErrBadIndex {
+		t.Errorf("index past total: error = %v, want %v", err, ErrBadIndex)
+	}
+
+	h, payload, err := Parse(Ack(3, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !h.IsAck() || h.MessageID != 3 || h.Index != 5 || len(payload) != 0 {
+		t.Errorf("Parse(Ack(3, 5)) = %+v, % x", h, payload)
+	}
+}
+
+func TestReassemblerChecksum(t *testing.T) {
+	frames, err := Split(1, []byte("hello, world"), MinMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames[0][HeaderSize] ^= 0xff
+
+	var r Reassembler
+	var lastErr error
+	for _, frame := range frames {
+		_, _, lastErr = r.Add(frame)
+	}
+	if lastErr != ErrChecksum {
+		t.Errorf("error = %v, want %v", lastErr, ErrChecksum)
+	}
+}
+
+func TestReassemblerLimits(t *testing.T) {
+	frame := func(id, index, total uint16, payload []byte) []byte {
+		h := Header{MessageID: id, Index: index, Total: total, Length: uint16(len(payload))}
+		return append(h.append(nil), payload...)
+	}
+
+	r := Reassembler{MaxFrames: 10, MaxMessages: 2, MaxBytes: 16}
+	if _, _, err := r.Add(frame(1, 0, 0xffff, nil)); err != ErrTooMany {
+		t.Errorf("65535 frames: error = %v, want %v", err, ErrTooMany)
+	}
+
+	for id := uint16(0); id < 100; id++ {
+		if _, _, err := r.Add(frame(id, 0, 10, []byte{1})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(r.messages) != 2 {
+		t.Errorf("%d incomplete messages kept, want 2", len(r.messages))
+	}
+
+	// Starting a third message drops the oldest one, leaving one byte.
+	if _, _, err := r.Add(frame(200, 0, 2, make([]byte, 16))); err != ErrBufferFull {
+		t.Errorf("over MaxBytes: error = %v, want %v", err, ErrBufferFull)
+	}
+	if r.size != 1 || len(r.messages) != 1 {
+		t.Errorf("%d bytes in %d messages buffered, want 1 in 1", r.size, len(r.messages))
+	}
+}
+
+func TestReassemblerTimeout(t *testing.T) {
+	r := Reassembler{Timeout: time.Minute}
+	frames, err := Split(1, make([]byte, 20), MinMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := r.Add(frames[0]); err != nil {
+		t.Fatal(err)
+	}
+	r.expire(time.Now().Add(time.Hour))
+	if len(r.messages) != 0 || r.size != 0 {
+		t.Errorf("expired message still kept: %d messages, %d bytes", len(r.messages), r.size)
+	}
+}
+
+func TestWriteBuffer(t *testing.T) {
+	frames, err := Split(1, make([]byte, 30), MinMTU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream := bytes.Join(frames, nil)
+
+	var w WriteBuffer
+	var got [][]byte
+	for offset := 0; offset < len(stream); offset += 5 {
+		end := min(offset+5, len(stream))
+		got = append(got, w.Write(offset, stream[offset:end])...)
+	}
+	if len(got) != len(frames) {
+		t.Fatalf("got %d frames, want %d", len(got), len(frames))
+	}
+	for i := range got {
+		if !bytes.Equal(got[i], frames[i]) {
+			t.Errorf("frame %d differs", i)
+		}
+	}
+
+	// A gap in the offsets drops the partial data.
+	w = WriteBuffer{}
+	w.Write(0, stream[:5])
+	if out := w.Write(10, stream[10:20]); out != nil {
+		t.Errorf("write after a gap returned %d frames", len(out))
+	}
+}
@@ -0,0 +1,505 @@
+// Package gateway forwards commands written to a GATT characteristic to an
+// HTTP backend and sends the replies back as notifications.
+//
+// A client writes a query string such as "cmd=user&name=Ann&age=30", or the
+// same command as the CBOR map {0: "user", 1: {"name": "Ann", "age": 30}},
+// to the command characteristic. The handler registered for cmd is called
+// and its result is notified on the response characteristic, wrapped in an
+// Envelope that carries the status and any error. Each client is answered
+// in the encoding of its last command.
+//
+// Notifications reach every subscribed client, so each reply is sealed with
+// the key of the client's session, which the client reads from the response
+// characteristic after connecting; see OpenReply.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+	"github.com/mikoaf/mikoafble/cbor"
+	"github.com/mikoaf/mikoafble/gateway/framing"
+	"github.com/mikoaf/mikoafble/rpc"
+)
+
+// reservedParams are read by the gateway itself and never forwarded.
+var reservedParams = map[string]bool{"cmd": true, "id": true}
+
+// Request is a command received from a BLE client.
+type Request struct {
+	// ID is the "id" parameter of the command, echoed in the reply.
+	ID      uint16
+	Command string
+	Params  url.Values
+	// Args holds the parameters with their types when the command was sent
+	// as CBOR or JSON-RPC. Params has the same values in text form.
+	Args    map[string]interface{}
+	Client  bluetooth.Connection
+	Backend *Backend
+}
+
+// Handler handles one command and returns the reply for the client. An
+// error is sent to the client as an envelope with the code of the Error it
+// wraps.
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Get returns a handler that forwards the command as a GET request.
+func Get(path string) Handler {
+	return func(ctx context.Context, req *Request) (*Response, error) {
+		return req.Backend.Get(ctx, path)
+	}
+}
+
+// Post returns a handler that forwards the named parameters as a JSON
+// object in a POST request.
+func Post(path string, params ...string) Handler {
+	return func(ctx context.Context, req *Request) (*Response, error) {
+		payload := make(map[string]string, len(params))
+		for _, name := range params {
+			payload[name] = req.Params.Get(name)
+		}
+		return req.Backend.Post(ctx, path, payload)
+	}
+}
+
+// Config describes the GATT service of a gateway and its backend.
+type Config struct {
+	ServiceUUID  bluetooth.UUID
+	CommandUUID  bluetooth.UUID
+	ResponseUUID bluetooth.UUID
+	// LocalName is advertised together with the service UUID.
+	LocalName string
+
+	// BaseURL defaults to DefaultBaseURL and Client to http.DefaultClient.
+	BaseURL string
+	Client  *http.Client
+
+	// IRKs, if set, are used to key connected devices by identity address
+	// so that a phone rotating its private address is tracked once.
+	IRKs map[bluetooth.MAC]bluetooth.IRK
+	// ConnectionHandler is called after the gateway has updated its list of
+	// connected devices.
+	ConnectionHandler func(device bluetooth.Device, connected bool)
+	// ErrorHandler is called with errors that cannot be returned to a
+	// caller, such as a route table that fails to reload.
+	ErrorHandler func(err error)
+
+	// Framing expects commands as frames of the framing package and sends
+	// replies framed to the negotiated MTU. It is off by default so that
+	// clients writing plain query strings keep working.
+	Framing bool
+	// MTU is assumed for a client until BlueZ reports the one negotiated
+	// with it. It defaults to framing.MinMTU.
+	MTU int
+	// FrameInterval is the pause between two notifications of a reply, so
+	// that a slow client is not overrun. It defaults to 5 ms.
+	FrameInterval time.Duration
+	// Window is the number of frames sent before waiting for the client
+	// to ack them. Zero never waits.
+	Window int
+	// AckTimeout is how long to wait for an ack before giving up on a
+	// reply. It defaults to 2 s.
+	AckTimeout time.Duration
+
+	// Envelope selects the encoding of replies.
+	Envelope EnvelopeMode
+	// Timeout limits how long a command may take. It defaults to 10 s.
+	Timeout time.Duration
+	// Workers is the number of commands run at once, 4 by default.
+	// QueueSize more may wait, 32 by default; beyond that clients get a
+	// busy reply.
+	Workers   int
+	QueueSize int
+	// Breaker guards the backend. It defaults to a Breaker with the default
+	// settings.
+	Breaker *Breaker
+	// ReplayInterval is how often queued commands are retried, see
+	// OpenQueue. It defaults to 5 s.
+	ReplayInterval time.Duration
+
+	// RPC, if set, handles writes that are JSON-RPC 2.0 messages, which are
+	// told apart from query strings by their leading '{' or '['. The
+	// JSON-RPC response is sealed for the client like an envelope and
+	// framed if Framing is set; once opened, it is the plain response that
+	// clients match to their request by its id.
+	RPC *rpc.Server
+}
+
+// Gateway owns the command service and routes commands to handlers.
+type Gateway struct {
+	adapter *bluetooth.Adapter
+	config  Config
+	backend *Backend
+
+	commandChar  bluetooth.Characteristic
+	responseChar bluetooth.Characteristic
+	adv          *bluetooth.Advertisement
+	// notify sends a notification on the response characteristic.
+	notify func(value []byte) error
+
+	sendMu    sync.Mutex // one framed reply at a time
+	messageID atomic.Uint32
+
+	mu       sync.Mutex
+	handlers map[string]Handler
+	routes   map[string]bool // commands installed by SetRoutes
+	devices  map[bluetooth.MAC]bluetooth.Device
+	sessions map[bluetooth.Connection]*session
+	acks     map[uint16]chan uint16
+
+	jobs chan job
+	// ctx is canceled by Stop, which then waits for the workers to return.
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
+
+	queue      *queue
+	deferred   map[string]Handler // unwrapped handlers of deferrable routes
+	stopReplay context.CancelFunc
+	replaying  sync.WaitGroup
+}
+
+// New creates a gateway on adapter. The adapter must be enabled before
+// Start is called.
+func New(adapter *bluetooth.Adapter, config Config) *Gateway {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	if config.ErrorHandler == nil {
+		config.ErrorHandler = func(err error) {}
+	}
+	if config.MTU == 0 {
+		config.MTU = framing.MinMTU
+	}
+	if config.FrameInterval == 0 {
+		config.FrameInterval = 5 * time.Millisecond
+	}
+	if config.AckTimeout == 0 {
+		config.AckTimeout = 2 * time.Second
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+	if config.Workers <= 0 {
+		config.Workers = 4
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 32
+	}
+	if config.Breaker == nil {
+		config.Breaker = &Breaker{}
+	}
+	if config.ReplayInterval <= 0 {
+		config.ReplayInterval = 5 * time.Second
+	}
+	g := &Gateway{
+		adapter:  adapter,
+		config:   config,
+		backend:  &Backend{BaseURL: config.BaseURL, Client: config.Client, Breaker: config.Breaker},
+		handlers: make(map[string]Handler),
+		devices:  make(map[bluetooth.MAC]bluetooth.Device),
+		sessions: make(map[bluetooth.Connection]*session),
+		acks:     make(map[uint16]chan uint16),
+		jobs:     make(chan job, config.QueueSize),
+		deferred: make(map[string]Handler),
+	}
+	g.notify = func(value []byte) error {
+		_, err := g.responseChar.Write(value)
+		return err
+	}
+	g.ctx, g.cancel = context.WithCancel(context.Background())
+	g.workers.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go g.worker()
+	}
+	return g
+}
+
+// Handle registers the handler for cmd, replacing any previous one.
+func (g *Gateway) Handle(cmd string, handler Handler) {
+	g.mu.Lock()
+	g.handlers[cmd] = handler
+	g.mu.Unlock()
+}
+
+// Backend returns the backend commands are forwarded to.
+func (g *Gateway) Backend() *Backend {
+	return g.backend
+}
+
+// Start registers the service and starts advertising it.
+func (g *Gateway) Start(ctx context.Context) error {
+	g.adapter.SetConnectionHandler(g.handleConnection)
+
+	svc := bluetooth.Service{
+		UUID: g.config.ServiceUUID,
+		Characteristics: []bluetooth.CharacteristicConfig{
+			{
+				UUID:       g.config.CommandUUID,
+				Flags:      bluetooth.CharacteristicWritePermission,
+				WriteEvent: g.handleWrite,
+				Handle:     &g.commandChar,
+			},
+			{
+				UUID:      g.config.ResponseUUID,
+				Flags:     bluetooth.CharacteristicNotifyPermission | bluetooth.CharacteristicReadPermission,
+				ReadEvent: g.handleRead,
+				Handle:    &g.responseChar,
+			},
+		},
+	}
+	if err := g.adapter.AddServiceContext(ctx, &svc); err != nil {
+		return err
+	}
+
+	g.adv = g.adapter.DefaultAdvertisement()
+	err := g.adv.Configure(bluetooth.AdvertisementOptions{
+		LocalName:    g.config.LocalName,
+		ServiceUUIDs: []bluetooth.UUID{g.config.ServiceUUID},
+	})
+	if err != nil {
+		return err
+	}
+	return g.adv.StartContext(ctx)
+}
+
+// Stop cancels the commands that are running and waits for them to return,
+// then stops advertising, disconnects all clients and closes the queue.
+// Commands waiting for a worker are dropped; those in the queue file are
+// kept for the next start. A stopped gateway cannot be started again.
+func (g *Gateway) Stop(ctx context.Context) error {
+	g.cancel()
+	done := make(chan struct{})
+	go func() {
+		g.workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	err := g.closeQueue()
+	if g.adv != nil {
+		if stopErr := g.adv.StopContext(ctx); stopErr != nil {
+			err = stopErr
+		}
+	}
+
+	g.mu.Lock()
+	devices := g.devices
+	g.devices = make(map[bluetooth.MAC]bluetooth.Device)
+	g.mu.Unlock()
+	for _, device := range devices {
+		device.DisconnectContext(ctx)
+	}
+	return err
+}
+
+// Devices returns the connected devices, keyed by identity address.
+func (g *Gateway) Devices() map[bluetooth.MAC]bluetooth.Device {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	devices := make(map[bluetooth.MAC]bluetooth.Device, len(g.devices))
+	for mac, device := range g.devices {
+		devices[mac] = device
+	}
+	return devices
+}
+
+func (g *Gateway) identity(device bluetooth.Device) bluetooth.MAC {
+	if id, ok := bluetooth.ResolveIdentity(device.Address.MAC, g.config.IRKs); ok {
+		return id
+	}
+	return device.Address.MAC
+}
+
+func (g *Gateway) handleConnection(device bluetooth.Device, connected bool) {
+	g.mu.Lock()
+	if connected {
+		g.devices[g.identity(device)] = device
+	} else {
+		delete(g.devices, g.identity(device))
+	}
+	g.mu.Unlock()
+	if !connected {
+		g.closeSession(device.Connection())
+	}
+
+	if g.config.ConnectionHandler != nil {
+		g.config.ConnectionHandler(device, connected)
+	}
+}
+
+// job is a command waiting for a worker.
+type job struct {
+	session *session
+	req     *Request
+	err     error  // set if the command could not be parsed
+	rpc     []byte // a JSON-RPC message instead of req
+}
+
+func (g *Gateway) handleWrite(client bluetooth.Connection, offset int, value []byte) {
+	g.session(client).trackMTU(g.commandChar.ClientMTU(client))
+	if g.config.Framing {
+		g.handleFrames(client, offset, value)
+		return
+	}
+	g.submit(client, value)
+}
+
+// submit queues the command in value for a worker, so that the D-Bus call
+// that delivered it returns at once.
+func (g *Gateway) submit(client bluetooth.Connection, value []byte) {
+	j := job{
+		session: g.session(client),
+		req:     &Request{Client: client, Backend: g.backend},
+	}
+	if g.config.RPC != nil && rpc.IsMessage(value) {
+		j.rpc = value
+	} else if cbor.IsMap(value) {
+		j.session.setMode(EnvelopeCBOR)
+		if err := parseCBORCommand(value, j.req); err != nil {
+			j.err = newError(CodeBadRequest, err)
+		}
+	} else if params, err := url.ParseQuery(string(value)); err != nil {
+		j.session.setMode(g.config.Envelope)
+		j.err = newError(CodeBadRequest, err)
+	} else {
+		j.session.setMode(g.config.Envelope)
+		j.req.Command = params.Get("cmd")
+		j.req.Params = params
+		if id, err := strconv.ParseUint(params.Get("id"), 10, 16); err == nil {
+			j.req.ID = uint16(id)
+		}
+	}
+
+	if g.ctx.Err() != nil {
+		// Stopped; the client is about to be disconnected.
+		return
+	}
+	select {
+	case g.jobs <- j:
+	default:
+		if j.rpc != nil {
+			// The server is not going to look at the message, so
+			// the ID is unknown.
+			resp, _ := json.Marshal(map[string]interface{}{
+				"jsonrpc": rpc.Version,
+				"id":      nil,
+				"error":   rpc.Error{Code: rpc.CodeServerError - int(CodeBusy), Message: "gateway busy"},
+			})
+			if err := g.deliver(j.session, resp); err != nil {
+				g.config.ErrorHandler(err)
+			}
+			return
+		}
+		g.send(j.session, envelopeFor(j.req, nil, &Error{Code: CodeBusy, Message: "gateway busy"}))
+	}
+}
+
+func (g *Gateway) worker() {
+	defer g.workers.Done()
+	for {
+		select {
+		case <-g.ctx.Done():
+			return
+		case j := <-g.jobs:
+			g.run(j)
+		}
+	}
+}
+
+// run runs a command and sends the reply to the client.
+func (g *Gateway) run(j job) {
+	if j.rpc != nil {
+		ctx, cancel := context.WithTimeout(g.ctx, g.config.Timeout)
+		g.runRPC(ctx, j.session, j.rpc)
+		cancel()
+		return
+	}
+	if j.err != nil {
+		g.send(j.session, envelopeFor(j.req, nil, j.err))
+		return
+	}
+	ctx, cancel := context.WithTimeout(g.ctx, g.config.Timeout)
+	defer cancel()
+	if err := j.session.begin(j.req.ID, cancel); err != nil {
+		g.send(j.session, envelopeFor(j.req, nil, err))
+		return
+	}
+	env := g.Serve(ctx, j.req)
+	j.session.end(j.req.ID)
+	g.send(j.session, env)
+}
+
+func (g *Gateway) send(s *session, env Envelope) {
+	env.Session = s.token
+	data, err := env.Marshal(s.envelopeMode())
+	if err == nil {
+		err = g.deliver(s, data)
+	}
+	if err != nil {
+		g.config.ErrorHandler(err)
+	}
+}
+
+// Serve runs the handler registered for req.Command and returns the
+// envelope to send to the client.
+func (g *Gateway) Serve(ctx context.Context, req *Request) Envelope {
+	resp, err := g.Dispatch(ctx, req)
+	return envelopeFor(req, resp, err)
+}
+
+func envelopeFor(req *Request, resp *Response, err error) Envelope {
+	env := Envelope{RequestID: req.ID}
+	if err != nil {
+		env.Code = errorCode(err)
+		env.Message = err.Error()
+		var e *Error
+		if errors.As(err, &e) {
+			env.Message = e.Message
+		}
+		if env.Code == CodeTimeout {
+			env.Message = "timeout"
+		}
+		return env
+	}
+	if resp == nil {
+		return env
+	}
+	env.Status = resp.Status
+	env.ContentType = resp.ContentType
+	env.Body = resp.Body
+	if resp.Status >= 400 {
+		env.Code = CodeBackendError
+		env.Message = http.StatusText(resp.Status)
+	}
+	return env
+}
+
+// Dispatch runs the handler registered for req.Command.
+func (g *Gateway) Dispatch(ctx context.Context, req *Request) (*Response, error) {
+	g.mu.Lock()
+	handler, ok := g.handlers[req.Command]
+	g.mu.Unlock()
+	if !ok {
+		return nil, ErrInvalidCommand
+	}
+	if req.Backend == nil {
+		req.Backend = g.backend
+	}
+	return handler(ctx, req)
+}
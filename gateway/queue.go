@@ -0,0 +1,310 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// queueResultTTL is how long finished commands can still be polled.
+	queueResultTTL = 24 * time.Hour
+	// queueCompactSlack is how many stale records the file may hold before
+	// it is rewritten.
+	queueCompactSlack = 256
+	// queueMaxResult limits the size of a stored reply. Larger replies are
+	// kept without their body.
+	queueMaxResult = 16 << 10
+)
+
+var errQueueClosed = errors.New("gateway: queue closed")
+
+// queueRecord is one line of the queue file. An "add" record queues a
+// command, a "done" record stores its result.
+type queueRecord struct {
+	Op      string `json:"op"`
+	Ticket  string `json:"ticket"`
+	Command string `json:"cmd,omitempty"`
+	Params  string `json:"params,omitempty"`
+	// Args are the typed arguments of a CBOR or JSON-RPC command.
+	Args map[string]interface{} `json:"args,omitempty"`
+	Time time.Time              `json:"time"`
+	// Result is the binary envelope of the reply.
+	Result []byte `json:"result,omitempty"`
+}
+
+// queue is an append-only file of commands waiting for the backend. Every
+// record is synced before it is acknowledged, so a command accepted as
+// queued survives a power cut.
+type queue struct {
+	path string
+	// onError is told about failures that do not affect the record being
+	// written, such as a failed compaction.
+	onError func(err error)
+
+	mu      sync.Mutex
+	file    *os.File
+	pending []queueRecord
+	results map[string]queueRecord
+	records int // lines in the file
+	closed  bool
+}
+
+func openQueue(path string, onError func(err error)) (*queue, error) {
+	q := &queue{path: path, onError: onError, results: make(map[string]queueRecord)}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	if err := q.compact(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *queue) load() error {
+	f, err := os.Open(q.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) != 0 {
+			var r queueRecord
+			dec := json.NewDecoder(bytes.NewReader(line))
+			dec.UseNumber()
+			// A write cut short by a crash leaves a partial last line,
+			// which was never acknowledged.
+			if dec.Decode(&r) == nil {
+				r.Result = keepableResult(r.Result)
+				q.apply(r)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// keepableResult returns result, or the same envelope without its body if
+// it is larger than queueMaxResult.
+func keepableResult(result []byte) []byte {
+	if len(result) <= queueMaxResult {
+		return result
+	}
+	env, err := UnmarshalEnvelope(result)
+	if err != nil {
+		env = Envelope{Code: CodeInternal}
+	}
+	env.ContentType = ""
+	env.Body = nil
+	env.Message = fmt.Sprintf("reply of %d bytes too large to keep", len(result))
+	trimmed, err := env.Marshal(EnvelopeBinary)
+	if err != nil {
+		return nil
+	}
+	return trimmed
+}
+
+func (q *queue) apply(r queueRecord) {
+	switch r.Op {
+	case "add":
+		q.pending = append(q.pending, r)
+	case "done":
+		for i, p := range q.pending {
+			if p.Ticket == r.Ticket {
+				q.pending = append(q.pending[:i], q.pending[i+1:]...)
+				break
+			}
+		}
+		q.results[r.Ticket] = r
+	}
+}
+
+// append writes r and applies it once it is synced. A failed compaction
+// afterwards does not undo the record, so it is only reported to onError
+// and tried again with the next record.
+func (q *queue) append(r queueRecord) error {
+	if q.closed {
+		return errQueueClosed
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	if q.file == nil {
+		if err := q.open(); err != nil {
+			return err
+		}
+	}
+	if _, err := q.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if err := q.file.Sync(); err != nil {
+		return err
+	}
+	q.records++
+	q.apply(r)
+	if q.records > len(q.pending)+len(q.results)+queueCompactSlack {
+		if err := q.compact(); err != nil {
+			q.onError(fmt.Errorf("gateway: compact queue: %w", err))
+		}
+	}
+	return nil
+}
+
+// open opens the queue file for appending.
+func (q *queue) open() error {
+	f, err := os.OpenFile(q.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	q.file = f
+	return nil
+}
+
+// compact rewrites the file with only the live records and drops results
+// nobody polled in time. The new file replaces the old one atomically.
+func (q *queue) compact() error {
+	now := time.Now()
+	for ticket, r := range q.results {
+		if now.Sub(r.Time) > queueResultTTL {
+			delete(q.results, ticket)
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(q.path), filepath.Base(q.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	records := 0
+	for _, r := range q.pending {
+		if err := enc.Encode(r); err != nil {
+			tmp.Close()
+			return err
+		}
+		records++
+	}
+	for _, r := range q.results {
+		if err := enc.Encode(r); err != nil {
+			tmp.Close()
+			return err
+		}
+		records++
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), q.path); err != nil {
+		return err
+	}
+	if dir, err := os.Open(filepath.Dir(q.path)); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+
+	// The old file is gone now, so nothing may be appended to it even if
+	// the new one cannot be opened.
+	if q.file != nil {
+		q.file.Close()
+		q.file = nil
+	}
+	q.records = records
+	return q.open()
+}
+
+// add queues a command and returns its ticket.
+func (q *queue) add(command, params string, args map[string]interface{}) (string, error) {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	ticket := hex.EncodeToString(id[:])
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	r := queueRecord{Op: "add", Ticket: ticket, Command: command, Params: params, Args: args, Time: time.Now()}
+	if err := q.append(r); err != nil {
+		return "", fmt.Errorf("gateway: queue command: %w", err)
+	}
+	return ticket, nil
+}
+
+// done stores the result of a queued command.
+func (q *queue) done(ticket string, result []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.append(queueRecord{Op: "done", Ticket: ticket, Time: time.Now(), Result: keepableResult(result)})
+}
+
+// next returns the oldest queued command.
+func (q *queue) next() (queueRecord, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) == 0 {
+		return queueRecord{}, false
+	}
+	return q.pending[0], true
+}
+
+func (q *queue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// status returns the position of a queued command, counting from 1, or its
+// result once it has run.
+func (q *queue) status(ticket string) (position int, result []byte, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, r := range q.pending {
+		if r.Ticket == ticket {
+			return i + 1, nil, true
+		}
+	}
+	if r, ok := q.results[ticket]; ok {
+		return 0, r.Result, true
+	}
+	return 0, nil, false
+}
+
+func (q *queue) close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	if q.file == nil {
+		return nil
+	}
+	err := q.file.Close()
+	q.file = nil
+	return err
+}
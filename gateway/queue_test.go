@@ -0,0 +1,166 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestQueueReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+	q, err := openQueue(path, func(err error) { t.Error(err) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := map[string]interface{}{"n": json.Number("3"), "tags": []interface{}{"a"}}
+	first, err := q.add("set", "x=1", args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := q.add("set", "x=2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	big, err := (&Envelope{Status: 200, ContentType: "text/plain", Body: bytes.Repeat([]byte("x"), 2*queueMaxResult)}).Marshal(EnvelopeBinary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.done(first, big); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	q, err = openQueue(path, func(err error) { t.Error(err) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.close()
+	r, ok := q.next()
+	if !ok || r.Ticket != second || r.Params != "x=2" {
+		t.Fatalf("next() = %+v, %v, want ticket %s", r, ok, second)
+	}
+	_, result, ok := q.status(first)
+	if !ok {
+		t.Fatalf("status(%s) unknown", first)
+	}
+	env, err := UnmarshalEnvelope(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Status != 200 || env.Body != nil || env.Message == "" {
+		t.Errorf("large result kept as %+v, want status without body", env)
+	}
+}
+
+func TestQueueArgs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+	q, err := openQueue(path, func(err error) { t.Error(err) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := map[string]interface{}{"n": json.Number("3"), "on": true, "tags": []interface{}{"a"}}
+	if _, err := q.add("set", "", args); err != nil {
+		t.Fatal(err)
+	}
+	q.close()
+
+	q, err = openQueue(path, func(err error) { t.Error(err) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.close()
+	r, _ := q.next()
+	if !reflect.DeepEqual(r.Args, args) {
+		t.Errorf("args = %#v, want %#v", r.Args, args)
+	}
+}
+
+func TestQueueCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+	q, err := openQueue(path, func(err error) { t.Error(err) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.close()
+	for i := 0; i < 2*queueCompactSlack; i++ {
+		ticket, err := q.add("set", "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i%2 == 0 {
+			continue
+		}
+		if err := q.done(ticket, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := len(q.pending) + len(q.results) + queueCompactSlack; q.records > want {
+		t.Errorf("%d records in the file, want at most %d", q.records, want)
+	}
+}
+
+func TestQueueClosed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue")
+	q, err := openQueue(path, func(err error) { t.Error(err) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket, err := q.add("set", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.done(ticket, nil); !errors.Is(err, errQueueClosed) {
+		t.Errorf("done() after close error = %v, want %v", err, errQueueClosed)
+	}
+	if _, err := q.add("set", "", nil); !errors.Is(err, errQueueClosed) {
+		t.Errorf("add() after close error = %v, want %v", err, errQueueClosed)
+	}
+	if q.file != nil {
+		t.Error("queue file reopened after close")
+	}
+}
+
+func TestStopWaitsForReplay(t *testing.T) {
+	errs := make(chan error, 10)
+	g := New(nil, Config{ReplayInterval: time.Millisecond, ErrorHandler: func(err error) { errs <- err }})
+	if err := g.OpenQueue(filepath.Join(t.TempDir(), "queue")); err != nil {
+		t.Fatal(err)
+	}
+	started := make(chan struct{})
+	g.mu.Lock()
+	g.deferred["slow"] = func(ctx context.Context, req *Request) (*Response, error) {
+		close(started)
+		// Finishes the call even though Stop cancels it.
+		time.Sleep(50 * time.Millisecond)
+		return &Response{Status: 200}, nil
+	}
+	q := g.queue
+	g.mu.Unlock()
+	ticket, err := q.add("slow", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	<-started
+	if err := g.Stop(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, result, _ := q.status(ticket); result == nil {
+		t.Error("result of the replayed command was not stored before Stop returned")
+	}
+	select {
+	case err := <-errs:
+		t.Errorf("ErrorHandler(%v)", err)
+	default:
+	}
+}
@@ -0,0 +1,96 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// Duration is a time.Duration written as a string such as "1.5s" in the
+// route table.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// RetryPolicy retries a backend call that failed because the backend could
+// not be reached or answered 502, 503 or 504. Only idempotent requests are
+// retried.
+type RetryPolicy struct {
+	// Attempts is the total number of calls, including the first.
+	Attempts int `json:"attempts"`
+	// Backoff is the delay before the first retry, doubled for each
+	// following one up to MaxBackoff. Each delay is jittered between half
+	// and the full value. Backoff defaults to 200 ms and MaxBackoff to 5 s.
+	Backoff    Duration `json:"backoff"`
+	MaxBackoff Duration `json:"max_backoff"`
+}
+
+// idempotent reports whether repeating a request with this method is safe.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func retryable(resp *Response, err error) bool {
+	if err != nil {
+		return errorCode(err) == CodeBackendUnavailable && err != errBackendUnavailable
+	}
+	switch resp.Status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// do calls fn until it succeeds, fails in a way that retrying will not fix,
+// or the attempts or ctx run out.
+func (p *RetryPolicy) do(ctx context.Context, fn func() (*Response, error)) (*Response, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := fn()
+		if attempt >= p.Attempts || !retryable(resp, err) {
+			return resp, err
+		}
+		select {
+		case <-ctx.Done():
+			return resp, err
+		case <-time.After(p.delay(attempt)):
+		}
+	}
+}
+
+// delay returns the jittered delay before the given retry, counting from 1.
+func (p *RetryPolicy) delay(retry int) time.Duration {
+	backoff := time.Duration(p.Backoff)
+	if backoff <= 0 {
+		backoff = 200 * time.Millisecond
+	}
+	maxBackoff := time.Duration(p.MaxBackoff)
+	if maxBackoff <= 0 {
+		maxBackoff = 5 * time.Second
+	}
+	for i := 1; i < retry && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxBackoff)
+	return backoff/2 + rand.N(backoff/2+1)
+}
@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		policy RetryPolicy
+		retry  int
+		want   time.Duration // before jitter
+	}{
+		{RetryPolicy{}, 1, 200 * time.Millisecond},
+		{RetryPolicy{}, 2, 400 * time.Millisecond},
+		{RetryPolicy{}, 5, 3200 * time.Millisecond},
+		{RetryPolicy{}, 6, 5 * time.Second},
+		{RetryPolicy{}, 100, 5 * time.Second},
+		{RetryPolicy{Backoff: Duration(time.Second), MaxBackoff: Duration(3 * time.Second)}, 2, 2 * time.Second},
+		{RetryPolicy{Backoff: Duration(time.Second), MaxBackoff: Duration(3 * time.Second)}, 3, 3 * time.Second},
+		{RetryPolicy{Backoff: Duration(10 * time.Second), MaxBackoff: Duration(time.Second)}, 1, time.Second},
+	}
+	for _, tt := range tests {
+		lowest, highest := tt.want, time.Duration(0)
+		for i := 0; i < 1000; i++ {
+			d := tt.policy.delay(tt.retry)
+			lowest, highest = min(lowest, d), max(highest, d)
+		}
+		if lowest < tt.want/2 || highest > tt.want {
+			t.Errorf("%+v retry %d: delays from %v to %v, want %v to %v", tt.policy, tt.retry, lowest, highest, tt.want/2, tt.want)
+		}
+		if highest-lowest < tt.want/4 {
+			t.Errorf("%+v retry %d: delays from %v to %v are hardly jittered", tt.policy, tt.retry, lowest, highest)
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		resp *Response
+		err  error
+		want bool
+	}{
+		{&Response{Status: http.StatusOK}, nil, false},
+		{&Response{Status: http.StatusInternalServerError}, nil, false},
+		{&Response{Status: http.StatusBadGateway}, nil, true},
+		{&Response{Status: http.StatusServiceUnavailable}, nil, true},
+		{&Response{Status: http.StatusGatewayTimeout}, nil, true},
+		{nil, newError(CodeBackendUnavailable, errors.New("connection refused")), true},
+		{nil, errBackendUnavailable, false}, // the breaker is open
+		{nil, context.DeadlineExceeded, false},
+		{nil, ErrInvalidCommand, false},
+	}
+	for _, tt := range tests {
+		if got := retryable(tt.resp, tt.err); got != tt.want {
+			t.Errorf("retryable(%+v, %v) = %v, want %v", tt.resp, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRouteRetriesIdempotentOnly(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		method     string
+		idempotent *bool
+		calls      int32
+	}{
+		{"GET", nil, 3},
+		{"PUT", nil, 3},
+		{"DELETE", nil, 3},
+		{"POST", nil, 1},
+		{"PATCH", nil, 1},
+		{"POST", &yes, 3},
+		{"GET", &no, 1},
+	}
+	for _, tt := range tests {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		route := Route{
+			Method:     tt.method,
+			Path:       "/",
+			Retry:      &RetryPolicy{Attempts: 3, Backoff: Duration(time.Millisecond)},
+			Idempotent: tt.idempotent,
+		}
+		req := &Request{Backend: &Backend{BaseURL: server.URL, Client: http.DefaultClient}}
+		resp, err := route.Handler()(context.Background(), req)
+		server.Close()
+		if err != nil || resp.Status != http.StatusServiceUnavailable {
+			t.Errorf("%s: Handler() = %+v, %v", tt.method, resp, err)
+		}
+		if got := calls.Load(); got != tt.calls {
+			t.Errorf("%s idempotent=%v: %d calls, want %d", tt.method, tt.idempotent != nil && *tt.idempotent, got, tt.calls)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Route forwards one command to the backend. Routes are usually loaded from
+// a JSON file so that new endpoints do not need a rebuild:
+//
+//	{"routes": [{
+//		"command": "user",
+//		"method":  "POST",
+//		"path":    "/user",
+//		"params":  {"name": {"required": true}, "age": {"type": "int"}},
+//		"timeout": "3s"
+//	}]}
+type Route struct {
+	Command string `json:"command"`
+	// Method defaults to GET.
+	Method string `json:"method"`
+	// Path may contain {name} placeholders, which are filled in from the
+	// parameter of that name.
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers"`
+	// Params lists the allowed parameters. A command carrying any other
+	// parameter is refused.
+	Params map[string]Param `json:"params"`
+
+	// Timeout limits the backend call, including retries. Zero leaves
+	// only the gateway timeout.
+	Timeout Duration `json:"timeout"`
+	// Retry is only used for idempotent methods unless Idempotent says
+	// the route is safe to repeat.
+	Retry      *RetryPolicy `json:"retry"`
+	Idempotent *bool        `json:"idempotent"`
+	// Deferrable commands are queued while the backend is unreachable,
+	// see Gateway.OpenQueue.
+	Deferrable bool `json:"deferrable"`
+}
+
+// Param describes how a query parameter is forwarded. Parameters that are
+// not used in the path go into the JSON body, or into the query string for
+// GET and DELETE requests.
+type Param struct {
+	// Field is the JSON field name, defaulting to the parameter name.
+	Field string `json:"field"`
+	// Type is one of "string" (the default), "int", "number" or "bool".
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+// errRouteTimeout is the cause of a context canceled by Route.Timeout, which
+// tells the breaker that the backend was too slow.
+var errRouteTimeout = errors.New("gateway: route timeout")
+
+type routeFile struct {
+	Routes []Route `json:"routes"`
+}
+
+// ParseRoutes parses a route table.
+func ParseRoutes(data []byte) ([]Route, error) {
+	var file routeFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("gateway: parse routes: %w", err)
+	}
+	seen := make(map[string]bool)
+	for i, route := range file.Routes {
+		if route.Command == "" || route.Path == "" {
+			return nil, fmt.Errorf("gateway: route %d: command and path are required", i)
+		}
+		if seen[route.Command] {
+			return nil, fmt.Errorf("gateway: route %q defined twice", route.Command)
+		}
+		seen[route.Command] = true
+		for name, param := range route.Params {
+			switch param.Type {
+			case "", "string", "int", "number", "bool":
+			default:
+				return nil, fmt.Errorf("gateway: route %q: param %q has unknown type %q", route.Command, name, param.Type)
+			}
+		}
+	}
+	return file.Routes, nil
+}
+
+// LoadRoutes reads a route table from a file.
+func LoadRoutes(path string) ([]Route, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRoutes(data)
+}
+
+// Handler returns the handler that forwards the command as described by r.
+func (r Route) Handler() Handler {
+	return func(ctx context.Context, req *Request) (*Response, error) {
+		path, body, query, err := r.build(req.Params, req.Args)
+		if err != nil {
+			return nil, newError(CodeBadRequest, err)
+		}
+		if len(query) != 0 {
+			path += "?" + query.Encode()
+		}
+		method := strings.ToUpper(r.Method)
+		if method == "" {
+			method = "GET"
+		}
+		if r.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeoutCause(ctx, time.Duration(r.Timeout), errRouteTimeout)
+			defer cancel()
+		}
+		call := func() (*Response, error) {
+			return req.Backend.Do(ctx, method, path, r.Headers, body)
+		}
+		if r.Retry == nil || !r.idempotent(method) {
+			return call()
+		}
+		return r.Retry.do(ctx, call)
+	}
+}
+
+func (r Route) idempotent(method string) bool {
+	if r.Idempotent != nil {
+		return *r.Idempotent
+	}
+	return idempotent(method)
+}
+
+// build checks every parameter against its type, fills in the path template
+// and sorts the remaining parameters into the JSON body or the query
+// string.
+func (r Route) build(params url.Values, args map[string]interface{}) (path string, body map[string]interface{}, query url.Values, err error) {
+	for name := range params {
+		if _, ok := r.Params[name]; !ok && !reservedParams[name] {
+			return "", nil, nil, fmt.Errorf("gateway: %s: param %q not allowed", r.Command, name)
+		}
+	}
+
+	inBody := r.Method != "" && !strings.EqualFold(r.Method, "GET") && !strings.EqualFold(r.Method, "DELETE")
+	path = r.Path
+	for name, param := range r.Params {
+		if !params.Has(name) {
+			if param.Required {
+				return "", nil, nil, fmt.Errorf("gateway: %s: param %q is required", r.Command, name)
+			}
+			continue
+		}
+		raw := params.Get(name)
+		value, err := param.coerce(raw)
+		if typed, ok := args[name]; ok && param.accepts(typed) {
+			value, err = typed, nil
+		}
+		if err != nil {
+			return "", nil, nil, fmt.Errorf("gateway: %s: param %q: %w", r.Command, name, err)
+		}
+		placeholder := "{" + name + "}"
+		if strings.Contains(path, placeholder) {
+			path = strings.ReplaceAll(path, placeholder, url.PathEscape(raw))
+			continue
+		}
+		field := param.Field
+		if field == "" {
+			field = name
+		}
+		if !inBody {
+			if query == nil {
+				query = make(url.Values)
+			}
+			query.Set(field, raw)
+			continue
+		}
+		if body == nil {
+			body = make(map[string]interface{})
+		}
+		body[field] = value
+	}
+	if strings.Contains(path, "{") {
+		return "", nil, nil, fmt.Errorf("gateway: %s: path %q has unfilled placeholders", r.Command, path)
+	}
+	if inBody && body == nil {
+		body = map[string]interface{}{}
+	}
+	return path, body, query, nil
+}
+
+// accepts reports whether a typed argument can be forwarded as it is. A
+// parameter without a type takes any value, so that types sent by the
+// client survive.
+func (p Param) accepts(v interface{}) bool {
+	switch v := v.(type) {
+	case int64, uint64:
+		return p.Type == "" || p.Type == "int" || p.Type == "number"
+	case json.Number:
+		_, err := v.Int64()
+		return p.Type == "" || p.Type == "number" || p.Type == "int" && err == nil
+	case float64:
+		return p.Type == "" || p.Type == "number"
+	case bool:
+		return p.Type == "" || p.Type == "bool"
+	case string:
+		return p.Type == "" || p.Type == "string"
+	}
+	return p.Type == ""
+}
+
+func (p Param) coerce(raw string) (interface{}, error) {
+	switch p.Type {
+	case "int":
+		return strconv.ParseInt(raw, 10, 64)
+	case "number":
+		return strconv.ParseFloat(raw, 64)
+	case "bool":
+		return strconv.ParseBool(raw)
+	}
+	return raw, nil
+}
+
+// SetRoutes replaces the routes installed by an earlier call with routes.
+// Handlers registered with Handle for other commands are kept.
+func (g *Gateway) SetRoutes(routes []Route) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for cmd := range g.routes {
+		delete(g.handlers, cmd)
+	}
+	g.routes = make(map[string]bool, len(routes))
+	g.deferred = make(map[string]Handler)
+	for _, route := range routes {
+		handler := route.Handler()
+		if route.Deferrable {
+			g.deferred[route.Command] = handler
+			handler = g.deferrable(handler)
+		}
+		g.handlers[route.Command] = handler
+		g.routes[route.Command] = true
+	}
+}
+
+// WatchRoutes loads the route table in path and reloads it whenever its
+// modification time changes, until ctx is done. The first load must
+// succeed; later errors go to Config.ErrorHandler and keep the old routes.
+func (g *Gateway) WatchRoutes(ctx context.Context, path string, interval time.Duration) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	routes, err := LoadRoutes(path)
+	if err != nil {
+		return err
+	}
+	g.SetRoutes(routes)
+
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	go func() {
+		modTime := info.ModTime()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			info, err := os.Stat(path)
+			if err != nil || info.ModTime().Equal(modTime) {
+				continue
+			}
+			modTime = info.ModTime()
+			routes, err := LoadRoutes(path)
+			if err != nil {
+				g.config.ErrorHandler(err)
+				continue
+			}
+			g.SetRoutes(routes)
+		}
+	}()
+	return nil
+}
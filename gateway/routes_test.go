@@ -0,0 +1,233 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var userRoute = Route{
+	Command: "user",
+	Method:  "POST",
+	Path:    "/user",
+	Params: map[string]Param{
+		"name":   {Required: true},
+		"age":    {Type: "int"},
+		"height": {Type: "number"},
+		"admin":  {Type: "bool", Field: "is_admin"},
+		"note":   {},
+	},
+}
+
+var searchRoute = Route{
+	Command: "search",
+	Path:    "/users/{group}",
+	Params: map[string]Param{
+		"group": {Type: "int", Required: true},
+		"age":   {Type: "int"},
+		"q":     {},
+	},
+}
+
+func TestRouteBuild(t *testing.T) {
+	tests := []struct {
+		name      string
+		route     Route
+		query     string
+		args      map[string]interface{}
+		wantPath  string
+		wantBody  map[string]interface{}
+		wantQuery url.Values
+	}{
+		{
+			name:     "query string types",
+			route:    userRoute,
+			query:    "cmd=user&id=4&name=Ann&age=30&height=1.7&admin=true",
+			wantPath: "/user",
+			wantBody: map[string]interface{}{"name": "Ann", "age": int64(30), "height": 1.7, "is_admin": true},
+		},
+		{
+			name:     "typed arguments",
+			route:    userRoute,
+			query:    "cmd=user&name=Ann&age=30&note=7",
+			args:     map[string]interface{}{"name": "Ann", "age": json.Number("30"), "note": int64(7)},
+			wantPath: "/user",
+			// An untyped parameter keeps the type the client sent.
+			wantBody: map[string]interface{}{"name": "Ann", "age": json.Number("30"), "note": int64(7)},
+		},
+		{
+			name:     "empty body",
+			route:    Route{Command: "ping", Method: "POST", Path: "/ping"},
+			query:    "cmd=ping",
+			wantPath: "/ping",
+			wantBody: map[string]interface{}{},
+		},
+		{
+			name:      "path and query",
+			route:     searchRoute,
+			query:     "cmd=search&group=3&age=30&q=a b",
+			wantPath:  "/users/3",
+			wantQuery: url.Values{"age": {"30"}, "q": {"a b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path, body, query, err := tt.route.build(params, tt.args)
+			if err != nil {
+				t.Fatalf("build() error = %v", err)
+			}
+			if path != tt.wantPath {
+				t.Errorf("path = %q, want %q", path, tt.wantPath)
+			}
+			if !reflect.DeepEqual(body, tt.wantBody) {
+				t.Errorf("body = %#v, want %#v", body, tt.wantBody)
+			}
+			if !reflect.DeepEqual(query, tt.wantQuery) {
+				t.Errorf("query = %v, want %v", query, tt.wantQuery)
+			}
+		})
+	}
+}
+
+func TestRouteRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		route Route
+		query string
+		args  map[string]interface{}
+	}{
+		{"missing required", userRoute, "cmd=user&age=30", nil},
+		{"unknown param", userRoute, "cmd=user&name=Ann&role=root", nil},
+		{"bad int in body", userRoute, "cmd=user&name=Ann&age=old", nil},
+		{"bad bool in body", userRoute, "cmd=user&name=Ann&admin=maybe", nil},
+		{"float for int", userRoute, "cmd=user&name=Ann&age=3.5", map[string]interface{}{"name": "Ann", "age": 3.5}},
+		{"bad int in query", searchRoute, "cmd=search&group=3&age=old", nil},
+		{"bad int in path", searchRoute, "cmd=search&group=x", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := &Request{Params: params, Args: tt.args, Backend: &Backend{}}
+			_, err = tt.route.Handler()(context.Background(), req)
+			if code := errorCode(err); code != CodeBadRequest {
+				t.Errorf("Handler() error = %v (%v), want %v", err, code, CodeBadRequest)
+			}
+		})
+	}
+}
+
+func TestParamAccepts(t *testing.T) {
+	tests := []struct {
+		typ   string
+		value interface{}
+		want  bool
+	}{
+		{"int", int64(1), true},
+		{"int", uint64(1), true},
+		{"int", json.Number("1"), true},
+		{"int", json.Number("1.5"), false},
+		{"int", 1.5, false},
+		{"int", "1", false},
+		{"number", json.Number("1.5"), true},
+		{"number", 1.5, true},
+		{"number", int64(1), true},
+		{"bool", true, true},
+		{"bool", "true", false},
+		{"string", "a", true},
+		{"string", int64(1), false},
+		{"", []interface{}{1}, true},
+		{"string", nil, false},
+	}
+	for _, tt := range tests {
+		if got := (Param{Type: tt.typ}).accepts(tt.value); got != tt.want {
+			t.Errorf("Param{Type: %q}.accepts(%#v) = %v, want %v", tt.typ, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseRoutesInvalid(t *testing.T) {
+	for _, data := range []string{
+		`{"routes": [{"command": "a"}]}`,
+		`{"routes": [{"command": "a", "path": "/a"}, {"command": "a", "path": "/b"}]}`,
+		`{"routes": [{"command": "a", "path": "/a", "params": {"x": {"type": "date"}}}]}`,
+		`{"routes": [{"command": "a", "path": "/a", "verb": "GET"}]}`,
+		`{"routes": [{"command": "a", "path": "/a", "timeout": "soon"}]}`,
+	} {
+		if _, err := ParseRoutes([]byte(data)); err == nil {
+			t.Errorf("ParseRoutes(%s) succeeded", data)
+		}
+	}
+}
+
+func TestWatchRoutes(t *testing.T) {
+	errs := make(chan error, 10)
+	g := New(nil, Config{ErrorHandler: func(err error) { errs <- err }})
+	defer g.Stop(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "routes.json")
+	write := func(data string, age time.Duration) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		// Set the modification time so that the change is seen even
+		// on file systems with a coarse clock.
+		mtime := time.Now().Add(age)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	has := func(cmd string) bool {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		_, ok := g.handlers[cmd]
+		return ok
+	}
+	g.Handle("hello", Get("/hello"))
+
+	write(`{"routes": [{"command": "a", "path": "/a"}]}`, -time.Hour)
+	if err := g.WatchRoutes(ctx, path, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if !has("a") {
+		t.Fatal("route a not installed")
+	}
+
+	write(`{"routes": [{"command": "b", "path": "/b"}]}`, -time.Minute)
+	deadline := time.Now().Add(5 * time.Second)
+	for !has("b") && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if !has("b") || has("a") || !has("hello") {
+		t.Fatalf("after reload: a %v, b %v, hello %v; want only b and hello", has("a"), has("b"), has("hello"))
+	}
+
+	// A broken table is reported and the old routes stay.
+	write(`{"routes": [`, 0)
+	select {
+	case <-errs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no error for a broken route table")
+	}
+	if !has("b") {
+		t.Error("route b dropped after a failed reload")
+	}
+
+	if err := g.WatchRoutes(ctx, filepath.Join(t.TempDir(), "missing.json"), 0); err == nil {
+		t.Error("WatchRoutes() of a missing file succeeded")
+	}
+}
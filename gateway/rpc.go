@@ -0,0 +1,101 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+	"github.com/mikoaf/mikoafble/rpc"
+)
+
+type clientKey struct{}
+
+// ClientFromContext returns the client that sent the JSON-RPC request being
+// handled.
+func ClientFromContext(ctx context.Context) (bluetooth.Connection, bool) {
+	client, ok := ctx.Value(clientKey{}).(bluetooth.Connection)
+	return client, ok
+}
+
+// runRPC handles a JSON-RPC message and delivers the response, if any, to
+// the client that sent it. The sealed reply opens to the plain response, so
+// standard JSON-RPC clients can read it once it is opened.
+func (g *Gateway) runRPC(ctx context.Context, s *session, msg []byte) {
+	ctx = context.WithValue(ctx, clientKey{}, s.conn)
+	if resp := g.config.RPC.Handle(ctx, msg); resp != nil {
+		if err := g.deliver(s, resp); err != nil {
+			g.config.ErrorHandler(err)
+		}
+	}
+}
+
+// RPCMethod returns a JSON-RPC method that runs the handler of cmd, so the
+// route table can be offered over JSON-RPC:
+//
+//	server.Register("user.create", gw.RPCMethod("user"))
+//
+// The members of a params object become the parameters of the command. A
+// JSON reply from the backend is the result as it is, any other reply is a
+// string. Failures are reported with the JSON-RPC code closest to the
+// gateway code, or CodeServerError minus the gateway code, with the gateway
+// code and HTTP status in the error data.
+func (g *Gateway) RPCMethod(cmd string) rpc.Handler {
+	return func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+		params := url.Values{"cmd": {cmd}}
+		var args map[string]interface{}
+		if len(raw) != 0 {
+			dec := json.NewDecoder(bytes.NewReader(raw))
+			dec.UseNumber()
+			if err := dec.Decode(&args); err != nil {
+				return nil, &rpc.Error{Code: rpc.CodeInvalidParams, Message: "params must be an object"}
+			}
+			for name, value := range args {
+				if !reservedParams[name] {
+					params.Set(name, argString(value))
+				}
+			}
+		}
+		req := &Request{Command: cmd, Params: params, Args: args, Backend: g.backend}
+		req.Client, _ = ClientFromContext(ctx)
+
+		env := g.Serve(ctx, req)
+		if env.Code != CodeOK {
+			return nil, rpcError(env)
+		}
+		if strings.HasPrefix(env.ContentType, "application/json") && json.Valid(env.Body) {
+			return json.RawMessage(env.Body), nil
+		}
+		return string(env.Body), nil
+	}
+}
+
+func rpcError(env Envelope) *rpc.Error {
+	code := rpc.CodeServerError - int(env.Code)
+	switch env.Code {
+	case CodeInvalidCommand:
+		code = rpc.CodeMethodNotFound
+	case CodeBadRequest:
+		code = rpc.CodeInvalidParams
+	case CodeInternal:
+		code = rpc.CodeInternalError
+	}
+	data := map[string]interface{}{"code": env.Code.String()}
+	if env.Status != 0 {
+		data["status"] = env.Status
+		if len(env.Body) != 0 {
+			data["body"] = string(env.Body)
+		}
+	}
+	message := env.Message
+	if message == "" && env.Status != 0 {
+		message = http.StatusText(env.Status)
+	}
+	if message == "" {
+		message = env.Code.String()
+	}
+	return &rpc.Error{Code: code, Message: message, Data: data}
+}
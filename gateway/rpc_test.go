@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+	"github.com/mikoaf/mikoafble/rpc"
+)
+
+// phone is a client of the gateway in tests.
+type phone struct {
+	conn    bluetooth.Connection
+	session uint32
+	key     []byte
+	client  *rpc.Client
+}
+
+func TestRPCRepliesPerClient(t *testing.T) {
+	server := rpc.NewServer()
+	server.Register("whoami", func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+		client, _ := ClientFromContext(ctx)
+		return client, nil
+	})
+	g := New(nil, Config{RPC: server})
+	defer g.Stop(context.Background())
+
+	phones := []*phone{{conn: 1}, {conn: 2}}
+	for _, p := range phones {
+		data, err := g.handleRead(p.conn, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		env, err := UnmarshalEnvelope(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.session, p.key = env.Session, env.Body
+		conn := p.conn
+		p.client = rpc.NewClient(func(ctx context.Context, data []byte) error {
+			g.handleWrite(conn, 0, data)
+			return nil
+		})
+	}
+	// Every subscribed phone gets every notification.
+	g.notify = func(value []byte) error {
+		for _, p := range phones {
+			reply, err := OpenReply(p.session, p.key, value)
+			if errors.Is(err, ErrOtherSession) {
+				continue
+			}
+			if err != nil {
+				t.Errorf("phone %d: %v", p.conn, err)
+				continue
+			}
+			if err := p.client.Receive(reply); err != nil {
+				t.Errorf("phone %d: %v", p.conn, err)
+			}
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, p := range phones {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Both clients number their first call 1.
+			for i := 0; i < 10; i++ {
+				var got bluetooth.Connection
+				if err := p.client.Call(ctx, "whoami", nil, &got); err != nil {
+					t.Errorf("phone %d: %v", p.conn, err)
+					return
+				}
+				if got != p.conn {
+					t.Errorf("phone %d got the reply for phone %d", p.conn, got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,211 @@
+package gateway
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+	"github.com/mikoaf/mikoafble/gateway/framing"
+)
+
+// SessionKeySize is the size of the AES key a client reads from the
+// response characteristic.
+const SessionKeySize = 16
+
+const sealNonceSize = 12
+
+var errDuplicateID = &Error{Code: CodeBadRequest, Message: "request id already in flight"}
+
+var (
+	// ErrOtherSession is returned by OpenReply for replies sent to
+	// another client.
+	ErrOtherSession = errors.New("gateway: reply for another session")
+	// ErrSealedReply is returned by OpenReply for replies that do not
+	// decrypt with the session key.
+	ErrSealedReply = errors.New("gateway: reply cannot be opened")
+)
+
+// session is the state kept for one connected client.
+type session struct {
+	conn bluetooth.Connection
+	// token identifies the session in sealed replies and envelopes. It is
+	// not secret; key is, and only the client reads it.
+	token uint32
+	key   [SessionKeySize]byte
+	aead  cipher.AEAD
+
+	mu          sync.Mutex
+	mode        EnvelopeMode // encoding of replies
+	mtu         int          // negotiated with the client, 0 if unknown
+	buffer      framing.WriteBuffer
+	reassembler framing.Reassembler
+	// pending cancels the commands still running, by request ID.
+	pending map[uint16]context.CancelFunc
+}
+
+// newToken returns a random session token. Zero is left for envelopes that
+// belong to no session.
+func newToken() uint32 {
+	var buf [4]byte
+	for {
+		rand.Read(buf[:])
+		if token := binary.BigEndian.Uint32(buf[:]); token != 0 {
+			return token
+		}
+	}
+}
+
+// session returns the session of conn, creating it if needed.
+func (g *Gateway) session(conn bluetooth.Connection) *session {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	s := g.sessions[conn]
+	if s == nil {
+		s = &session{
+			conn:    conn,
+			token:   newToken(),
+			mode:    g.config.Envelope,
+			pending: make(map[uint16]context.CancelFunc),
+			// A command that takes longer than Timeout to arrive would
+			// time out anyway.
+			reassembler: framing.Reassembler{Timeout: g.config.Timeout},
+		}
+		rand.Read(s.key[:])
+		s.aead = newAEAD(s.key[:])
+		g.sessions[conn] = s
+	}
+	return s
+}
+
+func newAEAD(key []byte) cipher.AEAD {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err) // the key size is fixed
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return aead
+}
+
+// seal encrypts a reply for the client. Notifications reach every
+// subscribed client, so only the session key keeps a reply private. The
+// sealed form is
+//
+//	session     uint32, big endian
+//	nonce       12 bytes
+//	ciphertext  AES-GCM with the session as additional data
+func (s *session) seal(reply []byte) []byte {
+	out := make([]byte, 4+sealNonceSize, 4+sealNonceSize+len(reply)+s.aead.Overhead())
+	binary.BigEndian.PutUint32(out, s.token)
+	rand.Read(out[4:])
+	return s.aead.Seal(out, out[4:], reply, out[:4])
+}
+
+// OpenReply decrypts a reply notified by the gateway, using the session and
+// key a client read from the response characteristic. Replies to other
+// clients give ErrOtherSession and are to be skipped.
+func OpenReply(session uint32, key []byte, data []byte) ([]byte, error) {
+	if len(key) != SessionKeySize {
+		return nil, errors.New("gateway: invalid session key size")
+	}
+	if len(data) < 4+sealNonceSize {
+		return nil, ErrSealedReply
+	}
+	if binary.BigEndian.Uint32(data) != session {
+		return nil, ErrOtherSession
+	}
+	reply, err := newAEAD(key).Open(nil, data[4:4+sealNonceSize], data[4+sealNonceSize:], data[:4])
+	if err != nil {
+		return nil, ErrSealedReply
+	}
+	return reply, nil
+}
+
+// closeSession cancels the commands of a client that went away.
+func (g *Gateway) closeSession(conn bluetooth.Connection) {
+	g.mu.Lock()
+	s := g.sessions[conn]
+	delete(g.sessions, conn)
+	g.mu.Unlock()
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	for _, cancel := range s.pending {
+		cancel()
+	}
+	s.pending = nil
+	s.mu.Unlock()
+}
+
+// begin records a command as pending. Two commands with the same ID at once
+// would make their replies ambiguous, so the second one is refused.
+func (s *session) begin(id uint16, cancel context.CancelFunc) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending == nil {
+		return errors.New("gateway: client disconnected")
+	}
+	if _, ok := s.pending[id]; ok {
+		return errDuplicateID
+	}
+	s.pending[id] = cancel
+	return nil
+}
+
+func (s *session) end(id uint16) {
+	s.mu.Lock()
+	delete(s.pending, id)
+	s.mu.Unlock()
+}
+
+// setMode makes replies follow the encoding of the client's commands.
+func (s *session) setMode(mode EnvelopeMode) {
+	s.mu.Lock()
+	s.mode = mode
+	s.mu.Unlock()
+}
+
+// trackMTU records the MTU BlueZ last reported for the client, if any.
+func (s *session) trackMTU(mtu int) {
+	if mtu == 0 {
+		return
+	}
+	s.mu.Lock()
+	s.mtu = mtu
+	s.mu.Unlock()
+}
+
+func (s *session) envelopeMode() EnvelopeMode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mode
+}
+
+// handleRead returns an envelope with the session of the client and its key
+// as the body. A read is answered to the reading device alone, unlike a
+// notification, so the key stays with the client. The envelope is encoded
+// like the replies, in Config.Envelope until the first command.
+func (g *Gateway) handleRead(client bluetooth.Connection, offset int) ([]byte, error) {
+	s := g.session(client)
+	s.trackMTU(g.responseChar.ClientMTU(client))
+	data, err := Envelope{
+		Session:     s.token,
+		ContentType: "application/octet-stream",
+		Body:        s.key[:],
+	}.Marshal(s.envelopeMode())
+	if err != nil {
+		return nil, err
+	}
+	if offset > len(data) {
+		return nil, errors.New("gateway: invalid offset")
+	}
+	return data[offset:], nil
+}
@@ -0,0 +1,79 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mikoaf/mikoafble/bluetooth"
+)
+
+func TestSessionKey(t *testing.T) {
+	g := New(nil, Config{})
+	defer g.Stop(context.Background())
+
+	keys := make(map[bluetooth.Connection]Envelope)
+	for _, conn := range []bluetooth.Connection{1, 2} {
+		data, err := g.handleRead(conn, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		env, err := UnmarshalEnvelope(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if env.Session == 0 || len(env.Body) != SessionKeySize {
+			t.Fatalf("handleRead(%d) = %+v, want a session and a key", conn, env)
+		}
+		keys[conn] = env
+	}
+	if keys[1].Session == keys[2].Session || bytes.Equal(keys[1].Body, keys[2].Body) {
+		t.Errorf("clients share a session: %+v, %+v", keys[1], keys[2])
+	}
+
+	// Reading again, also at an offset, returns the same key.
+	data, err := g.handleRead(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := Envelope{Session: keys[1].Session, ContentType: "application/octet-stream", Body: keys[1].Body}.Marshal(g.config.Envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, again[3:]) {
+		t.Errorf("handleRead(1, 3) = % x, want % x", data, again[3:])
+	}
+}
+
+func TestOpenReply(t *testing.T) {
+	g := New(nil, Config{})
+	defer g.Stop(context.Background())
+	s1, s2 := g.session(1), g.session(2)
+
+	reply := []byte("name=Ann")
+	sealed := s1.seal(reply)
+	if bytes.Contains(sealed, reply) {
+		t.Fatalf("sealed reply % x contains the plain text", sealed)
+	}
+	got, err := OpenReply(s1.token, s1.key[:], sealed)
+	if err != nil || !bytes.Equal(got, reply) {
+		t.Errorf("OpenReply() = %q, %v, want %q", got, err, reply)
+	}
+
+	if _, err := OpenReply(s2.token, s2.key[:], sealed); !errors.Is(err, ErrOtherSession) {
+		t.Errorf("OpenReply() with another session error = %v, want %v", err, ErrOtherSession)
+	}
+	// A client that pretends to own the session still lacks the key.
+	if _, err := OpenReply(s1.token, s2.key[:], sealed); !errors.Is(err, ErrSealedReply) {
+		t.Errorf("OpenReply() with another key error = %v, want %v", err, ErrSealedReply)
+	}
+	tampered := append([]byte{}, sealed...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := OpenReply(s1.token, s1.key[:], tampered); !errors.Is(err, ErrSealedReply) {
+		t.Errorf("OpenReply() of a tampered reply error = %v, want %v", err, ErrSealedReply)
+	}
+	if _, err := OpenReply(s1.token, s1.key[:], sealed[:10]); !errors.Is(err, ErrSealedReply) {
+		t.Errorf("OpenReply() of a short reply error = %v, want %v", err, ErrSealedReply)
+	}
+}
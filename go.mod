@@ -3,4 +3,4 @@ module github.com/mikoaf/mikoafble
 
 go 1.24.4
 
-require github.com/godbus/dbus/v5 v5.1.0 // indirect
+require github.com/godbus/dbus/v5 v5.1.0
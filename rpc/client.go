@@ -0,0 +1,232 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// ErrClosed is returned for calls still waiting when the client is closed.
+var ErrClosed = errors.New("rpc: client closed")
+
+// Client sends requests through a transport and matches the responses it is
+// given to the calls waiting for them.
+type Client struct {
+	send func(ctx context.Context, data []byte) error
+
+	mu      sync.Mutex
+	lastID  uint64
+	pending map[string]chan *response
+	closed  bool
+}
+
+// NewClient returns a client that sends messages with send. Messages
+// received from the server must be passed to Receive.
+func NewClient(send func(ctx context.Context, data []byte) error) *Client {
+	return &Client{send: send, pending: make(map[string]chan *response)}
+}
+
+// BatchElem is one call of a batch. Error is set if that call failed.
+type BatchElem struct {
+	Method string
+	Params interface{}
+	// Result receives the decoded result. It is ignored for notifications.
+	Result interface{}
+	// Notify sends the element as a notification, which gets no response.
+	Notify bool
+	Error  error
+}
+
+// Call calls method and decodes its result into result, which may be nil.
+// A JSON-RPC error is returned as an *Error.
+func (c *Client) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	batch := []BatchElem{{Method: method, Params: params, Result: result}}
+	if err := c.batch(ctx, batch, false); err != nil {
+		return err
+	}
+	return batch[0].Error
+}
+
+// Notify sends a notification, which the server does not answer.
+func (c *Client) Notify(ctx context.Context, method string, params interface{}) error {
+	return c.batch(ctx, []BatchElem{{Method: method, Params: params, Notify: true}}, false)
+}
+
+// BatchCall sends the calls in one message. The returned error is about the
+// batch as a whole; errors of single calls are in their Error field.
+func (c *Client) BatchCall(ctx context.Context, batch []BatchElem) error {
+	return c.batch(ctx, batch, true)
+}
+
+func (c *Client) batch(ctx context.Context, batch []BatchElem, asArray bool) error {
+	requests := make([]request, len(batch))
+	waits := make(map[string]int)
+	replies := make(chan *response, len(batch))
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrClosed
+	}
+	for i, elem := range batch {
+		params, err := encodeParams(elem.Params)
+		if err != nil {
+			c.mu.Unlock()
+			return err
+		}
+		requests[i] = request{Version: Version, Method: elem.Method, Params: params}
+		if elem.Notify {
+			continue
+		}
+		c.lastID++
+		id := strconv.FormatUint(c.lastID, 10)
+		requests[i].ID = json.RawMessage(id)
+		waits[id] = i
+		c.pending[id] = replies
+	}
+	c.mu.Unlock()
+	defer c.forget(waits)
+
+	var data []byte
+	var err error
+	if asArray {
+		data, err = json.Marshal(requests)
+	} else {
+		data, err = json.Marshal(requests[0])
+	}
+	if err != nil {
+		return err
+	}
+	if err := c.send(ctx, data); err != nil {
+		return err
+	}
+
+	answered := make(map[string]bool, len(waits))
+	for len(answered) < len(waits) {
+		var resp *response
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resp = <-replies:
+		}
+		if resp == nil {
+			return ErrClosed
+		}
+		if isNullID(resp.ID) {
+			// The server could not tell which call the error is about,
+			// and it answers each call at most once, so none of the calls
+			// still waiting will get a response.
+			for id, i := range waits {
+				if !answered[id] {
+					batch[i].Error = resp.err()
+				}
+			}
+			return nil
+		}
+		answered[string(resp.ID)] = true
+		elem := &batch[waits[string(resp.ID)]]
+		switch {
+		case resp.Error != nil:
+			elem.Error = resp.Error
+		case elem.Result != nil:
+			elem.Error = json.Unmarshal(resp.Result, elem.Result)
+		}
+	}
+	return nil
+}
+
+func encodeParams(params interface{}) (json.RawMessage, error) {
+	if params == nil {
+		return nil, nil
+	}
+	if raw, ok := params.(json.RawMessage); ok {
+		return raw, nil
+	}
+	return json.Marshal(params)
+}
+
+func (c *Client) forget(ids map[string]int) {
+	c.mu.Lock()
+	for id := range ids {
+		delete(c.pending, id)
+	}
+	c.mu.Unlock()
+}
+
+// Receive passes a message from the server to the waiting calls. Responses
+// to unknown IDs are dropped. An error with a null ID fails the calls still
+// waiting in the batch that the other responses of the message belong to,
+// or, if the message has no other responses, the only call or batch
+// waiting; otherwise it is dropped too.
+func (c *Client) Receive(data []byte) error {
+	var responses []*response
+	if isBatch(data) {
+		if err := json.Unmarshal(data, &responses); err != nil {
+			return err
+		}
+	} else {
+		var resp response
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return err
+		}
+		responses = append(responses, &resp)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var orphans []*response
+	var batch chan *response
+	for _, resp := range responses {
+		if isNullID(resp.ID) {
+			orphans = append(orphans, resp)
+			continue
+		}
+		if ch, ok := c.pending[string(resp.ID)]; ok {
+			delete(c.pending, string(resp.ID))
+			ch <- resp
+			batch = ch
+		}
+	}
+	if len(orphans) == 0 {
+		return nil
+	}
+	if batch == nil {
+		for _, ch := range c.pending {
+			if batch != nil && ch != batch {
+				// More than one batch waits; the error cannot be
+				// attributed.
+				return nil
+			}
+			batch = ch
+		}
+	}
+	if batch != nil {
+		// The batch returns on the first of them.
+		select {
+		case batch <- orphans[0]:
+		default:
+		}
+	}
+	return nil
+}
+
+func isNullID(id json.RawMessage) bool {
+	return len(id) == 0 || string(id) == "null"
+}
+
+// Close fails all waiting calls.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	for id, ch := range c.pending {
+		delete(c.pending, id)
+		select {
+		case ch <- nil:
+		default:
+		}
+	}
+	return nil
+}
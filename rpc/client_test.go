@@ -0,0 +1,160 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// loop returns a client whose messages are handled by s.
+func loop(t *testing.T, s *Server) *Client {
+	t.Helper()
+	var c *Client
+	c = NewClient(func(ctx context.Context, data []byte) error {
+		go func() {
+			if resp := s.Handle(ctx, data); resp != nil {
+				if err := c.Receive(resp); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+		return nil
+	})
+	return c
+}
+
+func testServer() *Server {
+	s := NewServer()
+	s.Register("add", Func(func(ctx context.Context, params [2]int) (int, error) {
+		return params[0] + params[1], nil
+	}))
+	s.Register("fail", Func(func(ctx context.Context, params struct{}) (int, error) {
+		return 0, &Error{Code: 7, Message: "failed"}
+	}))
+	return s
+}
+
+func TestClientCall(t *testing.T) {
+	c := loop(t, testServer())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var sum int
+	if err := c.Call(ctx, "add", [2]int{1, 2}, &sum); err != nil || sum != 3 {
+		t.Errorf("Call(add) = %d, %v, want 3", sum, err)
+	}
+
+	var e *Error
+	if err := c.Call(ctx, "fail", nil, nil); !errors.As(err, &e) || e.Code != 7 {
+		t.Errorf("Call(fail) error = %v, want code 7", err)
+	}
+	if err := c.Call(ctx, "sub", nil, nil); !errors.As(err, &e) || e.Code != CodeMethodNotFound {
+		t.Errorf("Call(sub) error = %v, want code %d", err, CodeMethodNotFound)
+	}
+	if err := c.Notify(ctx, "add", [2]int{1, 2}); err != nil {
+		t.Errorf("Notify(add) error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var sum int
+			if err := c.Call(ctx, "add", [2]int{i, i}, &sum); err != nil || sum != 2*i {
+				t.Errorf("Call(add, %d, %d) = %d, %v", i, i, sum, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClientBatch(t *testing.T) {
+	c := loop(t, testServer())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var a, b int
+	batch := []BatchElem{
+		{Method: "add", Params: [2]int{1, 1}, Result: &a},
+		{Method: "add", Params: [2]int{1, 1}, Notify: true},
+		{Method: "fail"},
+		{Method: "add", Params: [2]int{2, 2}, Result: &b},
+	}
+	if err := c.BatchCall(ctx, batch); err != nil {
+		t.Fatal(err)
+	}
+	if a != 2 || b != 4 || batch[0].Error != nil || batch[3].Error != nil {
+		t.Errorf("results = %d, %d, errors %v, %v", a, b, batch[0].Error, batch[3].Error)
+	}
+	if batch[2].Error == nil {
+		t.Error("batch[2].Error = nil, want the error of fail")
+	}
+}
+
+// TestClientNullID checks that errors the server sends with a null ID, which
+// it does for requests it cannot read, end the calls they are about.
+func TestClientNullID(t *testing.T) {
+	tests := []struct {
+		name   string
+		calls  int
+		answer string
+	}{
+		{"call", 1, `{"jsonrpc":"2.0","error":{"code":-32700,"message":"parse error"},"id":null}`},
+		{"batch", 2, `[{"jsonrpc":"2.0","result":2,"id":1},{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":null}]`},
+		{"whole batch", 2, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":null}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c *Client
+			c = NewClient(func(ctx context.Context, data []byte) error {
+				go c.Receive([]byte(tt.answer))
+				return nil
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			batch := make([]BatchElem, tt.calls)
+			for i := range batch {
+				batch[i] = BatchElem{Method: "add", Params: [2]int{1, 1}}
+			}
+			if err := c.BatchCall(ctx, batch); err != nil {
+				t.Fatalf("BatchCall() error = %v", err)
+			}
+			var e *Error
+			if last := batch[len(batch)-1].Error; !errors.As(last, &e) || e.Code > CodeInvalidRequest {
+				t.Errorf("last call error = %v, want the null ID error", last)
+			}
+			if tt.name == "batch" && batch[0].Error != nil {
+				t.Errorf("answered call error = %v", batch[0].Error)
+			}
+		})
+	}
+}
+
+func TestClientClose(t *testing.T) {
+	c := NewClient(func(ctx context.Context, data []byte) error { return nil })
+	done := make(chan error)
+	go func() {
+		done <- c.Call(context.Background(), "add", nil, nil)
+	}()
+	// Close only fails calls that are already waiting.
+	for {
+		c.mu.Lock()
+		n := len(c.pending)
+		c.mu.Unlock()
+		if n != 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	c.Close()
+	if err := <-done; !errors.Is(err, ErrClosed) {
+		t.Errorf("Call() error = %v, want %v", err, ErrClosed)
+	}
+	if err := c.Call(context.Background(), "add", nil, nil); !errors.Is(err, ErrClosed) {
+		t.Errorf("Call() after Close error = %v, want %v", err, ErrClosed)
+	}
+}
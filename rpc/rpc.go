@@ -0,0 +1,95 @@
+// Package rpc implements JSON-RPC 2.0 for use over BLE.
+//
+// The package does not depend on a transport: a Server turns request bytes
+// into response bytes, and a Client sends requests through a function and is
+// fed the responses it receives. The gateway package carries it over a
+// write and a notify characteristic.
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Version is the protocol version sent in every message.
+const Version = "2.0"
+
+// Error codes defined by the JSON-RPC 2.0 specification. Codes from -32000
+// to -32099 are reserved for server errors.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeServerError    = -32000
+)
+
+// Error is a JSON-RPC error object. Handlers return it to choose the code;
+// other errors are sent as CodeInternalError.
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rpc: %s (%d)", e.Message, e.Code)
+}
+
+// request is a call or, without an ID, a notification.
+type request struct {
+	Version string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+func (r *request) isNotification() bool {
+	return r.ID == nil
+}
+
+type response struct {
+	Version string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// err returns the error of the response, or one saying there is none if a
+// response without result or error has a null ID.
+func (r *response) err() error {
+	if r.Error == nil {
+		return &Error{Code: CodeInvalidRequest, Message: "response without id"}
+	}
+	return r.Error
+}
+
+var null = json.RawMessage("null")
+
+// isBatch reports whether data holds a JSON array, skipping leading white
+// space.
+func isBatch(data []byte) bool {
+	for _, c := range data {
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return c == '['
+	}
+	return false
+}
+
+// IsMessage reports whether data looks like a JSON-RPC message, that is a
+// JSON object or array. It lets a transport share a characteristic with
+// another protocol.
+func IsMessage(data []byte) bool {
+	for _, c := range data {
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return c == '{' || c == '['
+	}
+	return false
+}
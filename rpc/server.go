@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// Handler handles a method call. params is the raw "params" member, which
+// may be empty. The result is encoded as JSON.
+type Handler func(ctx context.Context, params json.RawMessage) (interface{}, error)
+
+// Func adapts a function taking decoded parameters to a Handler. Parameters
+// that do not decode into P are refused with CodeInvalidParams.
+func Func[P, R any](fn func(ctx context.Context, params P) (R, error)) Handler {
+	return func(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+		var params P
+		if len(raw) != 0 {
+			if err := json.Unmarshal(raw, &params); err != nil {
+				return nil, &Error{Code: CodeInvalidParams, Message: err.Error()}
+			}
+		}
+		return fn(ctx, params)
+	}
+}
+
+// Server dispatches JSON-RPC requests to registered methods.
+type Server struct {
+	mu      sync.RWMutex
+	methods map[string]Handler
+}
+
+// NewServer returns a server without methods.
+func NewServer() *Server {
+	return &Server{methods: make(map[string]Handler)}
+}
+
+// DefaultServer is the server used by Register.
+var DefaultServer = NewServer()
+
+// Register adds a method to DefaultServer.
+func Register(method string, handler Handler) {
+	DefaultServer.Register(method, handler)
+}
+
+// Register adds a method, replacing any previous one with the same name.
+func (s *Server) Register(method string, handler Handler) {
+	s.mu.Lock()
+	s.methods[method] = handler
+	s.mu.Unlock()
+}
+
+// Handle runs a request or a batch and returns the response to send back.
+// It returns nil when there is nothing to send, which is the case for
+// notifications and batches of only notifications. Calls in a batch are
+// run one after the other.
+func (s *Server) Handle(ctx context.Context, data []byte) []byte {
+	if !isBatch(data) {
+		resp := s.handleOne(ctx, data)
+		if resp == nil {
+			return nil
+		}
+		out, _ := json.Marshal(resp)
+		return out
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		out, _ := json.Marshal(errorResponse(null, &Error{Code: CodeParseError, Message: "parse error"}))
+		return out
+	}
+	if len(batch) == 0 {
+		out, _ := json.Marshal(errorResponse(null, &Error{Code: CodeInvalidRequest, Message: "empty batch"}))
+		return out
+	}
+	var responses []*response
+	for _, msg := range batch {
+		if resp := s.handleOne(ctx, msg); resp != nil {
+			responses = append(responses, resp)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+	out, _ := json.Marshal(responses)
+	return out
+}
+
+func (s *Server) handleOne(ctx context.Context, data []byte) *response {
+	var req request
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(&req); err != nil {
+		// Valid JSON of the wrong shape is an invalid request; anything
+		// else, including an empty message, did not parse.
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return errorResponse(null, &Error{Code: CodeInvalidRequest, Message: "invalid request"})
+		}
+		return errorResponse(null, &Error{Code: CodeParseError, Message: "parse error"})
+	}
+	// An ID that is not allowed cannot be echoed, so the error goes out
+	// with a null ID as the specification asks.
+	id := req.ID
+	if id == nil || !validID(id) {
+		id = null
+	}
+	if req.Version != Version || req.Method == "" || !validID(req.ID) {
+		return errorResponse(id, &Error{Code: CodeInvalidRequest, Message: "invalid request"})
+	}
+	if len(req.Params) != 0 && req.Params[0] != '{' && req.Params[0] != '[' {
+		return errorResponse(id, &Error{Code: CodeInvalidParams, Message: "params must be an object or an array"})
+	}
+
+	s.mu.RLock()
+	handler, ok := s.methods[req.Method]
+	s.mu.RUnlock()
+
+	var resp *response
+	if !ok {
+		resp = errorResponse(id, &Error{Code: CodeMethodNotFound, Message: "method not found"})
+	} else {
+		resp = call(ctx, handler, req.Params, id)
+	}
+	if req.isNotification() {
+		return nil
+	}
+	return resp
+}
+
+func call(ctx context.Context, handler Handler, params json.RawMessage, id json.RawMessage) (resp *response) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp = errorResponse(id, &Error{Code: CodeInternalError, Message: "internal error"})
+		}
+	}()
+	result, err := handler(ctx, params)
+	if err != nil {
+		var e *Error
+		if !errors.As(err, &e) {
+			e = &Error{Code: CodeInternalError, Message: err.Error()}
+		}
+		return errorResponse(id, e)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(id, &Error{Code: CodeInternalError, Message: err.Error()})
+	}
+	return &response{Version: Version, Result: data, ID: id}
+}
+
+// validID accepts the ID types the specification allows: a string, a
+// number or null, or no ID at all for a notification.
+func validID(id json.RawMessage) bool {
+	if id == nil {
+		return true
+	}
+	switch id[0] {
+	case '"', 'n', '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return true
+	}
+	return false
+}
+
+func errorResponse(id json.RawMessage, err *Error) *response {
+	return &response{Version: Version, Error: err, ID: id}
+}
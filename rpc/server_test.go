@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestServerHandle(t *testing.T) {
+	s := NewServer()
+	s.Register("add", Func(func(ctx context.Context, params [2]int) (int, error) {
+		return params[0] + params[1], nil
+	}))
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"call", `{"jsonrpc":"2.0","method":"add","params":[1,2],"id":1}`, `{"jsonrpc":"2.0","result":3,"id":1}`},
+		{"notification", `{"jsonrpc":"2.0","method":"add","params":[1,2]}`, ``},
+		{"unknown method", `{"jsonrpc":"2.0","method":"sub","id":"a"}`, `{"jsonrpc":"2.0","error":{"code":-32601,"message":"method not found"},"id":"a"}`},
+		{"bad params", `{"jsonrpc":"2.0","method":"add","params":"x","id":2}`, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"params must be an object or an array"},"id":2}`},
+		{"parse error", `{"jsonrpc":"2.0","method"`, `{"jsonrpc":"2.0","error":{"code":-32700,"message":"parse error"},"id":null}`},
+		{"empty", ``, `{"jsonrpc":"2.0","error":{"code":-32700,"message":"parse error"},"id":null}`},
+		{"white space", " \n\t", `{"jsonrpc":"2.0","error":{"code":-32700,"message":"parse error"},"id":null}`},
+		{"not an object", `1`, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":null}`},
+		{"wrong version", `{"jsonrpc":"1.0","method":"add","id":3}`, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":3}`},
+		{"array ID", `{"jsonrpc":"2.0","method":"add","id":[]}`, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":null}`},
+		{"object ID", `{"jsonrpc":"2.0","method":"add","id":{}}`, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":null}`},
+		{"empty batch", `[]`, `{"jsonrpc":"2.0","error":{"code":-32600,"message":"empty batch"},"id":null}`},
+		{
+			"batch",
+			`[{"jsonrpc":"2.0","method":"add","params":[1,1],"id":1},{"jsonrpc":"2.0","method":"add","params":[1,1]},1]`,
+			`[{"jsonrpc":"2.0","result":2,"id":1},{"jsonrpc":"2.0","error":{"code":-32600,"message":"invalid request"},"id":null}]`,
+		},
+		{"batch of notifications", `[{"jsonrpc":"2.0","method":"add","params":[1,1]}]`, ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := s.Handle(context.Background(), []byte(tt.in))
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("Handle(%q) = %s, want no response", tt.in, got)
+				}
+				return
+			}
+			if !jsonEqual(t, got, []byte(tt.want)) {
+				t.Errorf("Handle(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func jsonEqual(t *testing.T, a, b []byte) bool {
+	t.Helper()
+	var va, vb interface{}
+	if err := json.Unmarshal(a, &va); err != nil {
+		t.Fatalf("invalid JSON %q: %v", a, err)
+	}
+	if err := json.Unmarshal(b, &vb); err != nil {
+		t.Fatalf("invalid JSON %q: %v", b, err)
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return string(ja) == string(jb)
+}
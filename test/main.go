@@ -1,146 +1,146 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
-	"net/url"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/mikoaf/mikoafble/bluetooth"
+	"github.com/mikoaf/mikoafble/gateway"
+	"github.com/mikoaf/mikoafble/rpc"
 )
 
 var adapter = bluetooth.DefaultAdapter
 
-var notifyChar bluetooth.Characteristic //notify characteristic
-
-var connectedDevices = make(map[bluetooth.Address]bluetooth.Device)
+var (
+	serviceUUID  = mustParseUUID("12345678-1234-5678-1234-56789abcdef0")
+	commandUUID  = mustParseUUID("abcdef01-1234-5678-1234-56789abcdef0")
+	responseUUID = mustParseUUID("abcdef03-1234-5678-1234-56789abcdef0")
+)
 
-func callAPI(path string, payload interface{}) ([]byte, error) {
-	var resp *http.Response
-	var err error
-	if payload == nil {
-		resp, err = http.Get("http://localhost:9000" + path)
-	} else {
-		body, _ := json.Marshal(payload)
-		resp, err = http.Post("http://localhost:9000"+path, "application/json", bytes.NewBuffer(body))
-	}
+func mustParseUUID(s string) bluetooth.UUID {
+	uuid, err := bluetooth.ParseUUID(s)
 	if err != nil {
-		return nil, err
+		panic(err)
 	}
-	defer resp.Body.Close()
-	buf := new(bytes.Buffer)
-	buf.ReadFrom(resp.Body)
-	return buf.Bytes(), nil
+	return uuid
 }
 
 func setupPeripheral() error {
+	// Field units do not always enumerate the dongle as hci0.
+	if powered, err := bluetooth.FirstPoweredAdapter(); err == nil {
+		adapter = powered
+	}
+
 	err := adapter.Enable()
 	if err != nil {
 		return err
 	}
 
-	//Connection handler
-	adapter.SetConnectionHandler(func(device bluetooth.Device, connected bool) {
-		if connected {
-			log.Printf("Device connected: %s\n", device.Address.String())
-			connectedDevices[device.Address] = device
-		} else {
-			log.Printf("Device disconnected: %s\n", device.Address)
-			delete(connectedDevices, device.Address)
-		}
-	})
+	irks, err := adapter.LoadIRKs()
+	if err != nil {
+		log.Printf("Could not load IRKs: %v\n", err)
+	}
 
-	// Write handler
-	writeHandler := func(conn bluetooth.Connection, offset int, value []byte) {
-		qs := string(value)
-		fmt.Println("Write received:", qs)
-		params, _ := url.ParseQuery(qs)
-		cmd := params.Get("cmd")
-		var resp []byte
-		switch cmd {
-		case "hello":
-			resp, _ = callAPI("/hello", nil)
-		case "user":
-			resp, _ = callAPI("/user", map[string]string{"name": params.Get("name"), "age": params.Get("age")})
-		case "greeting":
-			resp, _ = callAPI("/greeting", map[string]string{"name": params.Get("name")})
+	adapter.SetRecoveryHandler(func(event bluetooth.RecoveryEvent) {
+		switch {
+		case event.Lost:
+			log.Println("bluetoothd went away, waiting for it to come back")
+		case event.Err != nil:
+			log.Printf("bluetoothd restarted, recovery failed: %v\n", event.Err)
 		default:
-			resp = []byte("invalid cmd")
+			log.Printf("bluetoothd restarted, restored %d services and %d advertisements\n", event.Services, event.Advertisements)
 		}
+	})
 
-		notifyChar.Write(resp) // Send back via notification
+	// Only let known phones drive the backend when an allowlist is given,
+	// e.g. BLE_ALLOWLIST=AA:BB:CC:DD:EE:FF,11:22:33:44:55:66
+	if list := os.Getenv("BLE_ALLOWLIST"); list != "" {
+		policy := &bluetooth.AccessPolicy{}
+		for _, s := range strings.Split(list, ",") {
+			mac, err := bluetooth.ParseMAC(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("BLE_ALLOWLIST: %w", err)
+			}
+			policy.Allow = append(policy.Allow, mac)
+		}
+		adapter.SetAccessPolicy(policy)
 	}
+	adapter.SetAccessDeniedHandler(func(event bluetooth.AccessDeniedEvent) {
+		log.Printf("Refused %s from %s\n", event.Reason, event.Device.Address)
+	})
 
-	// Define service and characteristics
-	svc := bluetooth.Service{
-		UUID: bluetooth.NewUUID([16]byte{0x12, 0x34, 0x56, 0x78, 0x12, 0x34, 0x56, 0x78, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}),
-		Characteristics: []bluetooth.CharacteristicConfig{
-			{
-				UUID:       bluetooth.NewUUID([16]byte{0xab, 0xcd, 0xef, 0x01, 0x12, 0x34, 0x56, 0x78, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}),
-				Flags:      bluetooth.CharacteristicWritePermission,
-				WriteEvent: writeHandler,
-			},
-			{
-				UUID:   bluetooth.NewUUID([16]byte{0xab, 0xcd, 0xef, 0x03, 0x12, 0x34, 0x56, 0x78, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}),
-				Flags:  bluetooth.CharacteristicNotifyPermission,
-				Handle: &notifyChar, // Simpan pointer agar bisa kita gunakan nanti
-			},
+	config := gateway.Config{
+		ServiceUUID:  serviceUUID,
+		CommandUUID:  commandUUID,
+		ResponseUUID: responseUUID,
+		LocalName:    "GoBLE",
+		BaseURL:      os.Getenv("BLE_BACKEND"),
+		IRKs:         irks,
+		// Clients that speak the framing protocol can send commands
+		// larger than one write.
+		Framing: os.Getenv("BLE_FRAMING") != "",
+		Window:  8,
+		ErrorHandler: func(err error) {
+			log.Printf("Gateway: %v\n", err)
+		},
+		ConnectionHandler: func(device bluetooth.Device, connected bool) {
+			if connected {
+				log.Printf("Device connected: %s\n", device.Address)
+			} else {
+				log.Printf("Device disconnected: %s\n", device.Address)
+			}
 		},
 	}
-
-	// Tambahkan service ke adapter
-	err = adapter.AddService(&svc)
-	if err != nil {
+	if os.Getenv("BLE_ENVELOPE") == "json" {
+		config.Envelope = gateway.EnvelopeJSON
+	}
+	// Clients speak JSON-RPC 2.0; query strings are still understood for
+	// older app versions.
+	config.RPC = rpc.DefaultServer
+	gw := gateway.New(adapter, config)
+	rpc.Register("hello", gw.RPCMethod("hello"))
+	rpc.Register("user.create", gw.RPCMethod("user"))
+	rpc.Register("greeting", gw.RPCMethod("greeting"))
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// The route table is reloaded when it changes, so new backend
+	// endpoints only need an edit to the file.
+	routes := os.Getenv("BLE_ROUTES")
+	if routes == "" {
+		routes = "routes.json"
+	}
+	if err := gw.WatchRoutes(ctx, routes, 0); err != nil {
 		return err
 	}
 
-	// Mulai advertising
-	adv := adapter.DefaultAdvertisement()
-	adv.Configure(bluetooth.AdvertisementOptions{
-		LocalName:    "GoBLE",
-		ServiceUUIDs: []bluetooth.UUID{svc.UUID},
-	})
-	err = adv.Start()
-	if err != nil {
+	// Deferrable commands wait here while the backend is unreachable.
+	queue := os.Getenv("BLE_QUEUE")
+	if queue == "" {
+		queue = "gateway.queue"
+	}
+	if err := gw.OpenQueue(queue); err != nil {
 		return err
 	}
 
+	if err := gw.Start(ctx); err != nil {
+		return err
+	}
+	if err := adapter.SetDiscoverable(true, 0); err != nil {
+		return err
+	}
 	log.Println("Advertising...")
-	// address, _ := adapter.Address()
-	// fmt.Println(address)
-	// <-context.Background().Done()
-	// adv.Stop()
-
-	//context untuk cek keyboard interupt
-	ctx, cancel := context.WithCancel(context.Background())
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-
-	go func() {
-		sig := <-sigChan
-		log.Printf("Received signal: %v. Initiating shutdown...", sig)
-		cancel()
-	}()
-
-	//kalau done stop advertisement dan disconnect semua client
+
 	<-ctx.Done()
 	log.Println("Shutting down BLE services")
-	log.Println("Stopping advertisement...")
-	if err := adv.Stop(); err != nil {
-		log.Printf("Error stopping advertisement: %v\n", err)
-	}
-
-	log.Println("Advertisement stopped")
-	log.Println("Disconnecting any active client connections...")
-	for addr, dev := range connectedDevices {
-		dev.Disconnect()
-		delete(connectedDevices, addr)
+	if err := gw.Stop(context.Background()); err != nil {
+		log.Printf("Error stopping gateway: %v\n", err)
 	}
 	return nil
 }